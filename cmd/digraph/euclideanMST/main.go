@@ -0,0 +1,37 @@
+package main
+
+//   Compute a Euclidean minimum spanning tree of points in the plane.
+//   The input file contains the number of points n followed by n pairs of coordinates.
+
+//   % go run main.go points.txt
+//   0-4 0.70711
+//   1-4 0.70711
+//   3-4 0.70711
+//   2-4 0.70711
+//   2.82843
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/handane123/algorithms/digraph"
+	"github.com/handane123/algorithms/fundamentals/geometry"
+	"github.com/handane123/algorithms/io/stdin"
+)
+
+func main() {
+	in := stdin.NewInFileWords(os.Args[1])
+	n := in.ReadInt()
+	points := make([]*geometry.Point2D, n)
+	for i := 0; i < n; i++ {
+		x := in.ReadFloat64()
+		y := in.ReadFloat64()
+		points[i] = geometry.NewPoint2D(x, y)
+	}
+
+	mst := digraph.NewEuclideanMST(points)
+	for _, e := range mst.Edges() {
+		fmt.Println(e)
+	}
+	fmt.Printf("%.5f\n", mst.Weight())
+}
@@ -0,0 +1,46 @@
+package main
+
+//   Compute a minimum spanning forest, one tree per connected component,
+//   and the replacement edge of every tree edge.
+
+//   % go run main.go tinyEWG.txt
+//   1 trees, weight 1.81000
+//   tree 0 (1.81000): 0 1 2 3 4 5 6 7
+//     0-7 0.16000  replaced by 1-3 0.29000
+//     2-3 0.17000  replaced by 1-3 0.29000
+//     1-7 0.19000  replaced by 1-3 0.29000
+//     0-2 0.26000  replaced by 1-3 0.29000
+//     5-7 0.28000  replaced by 1-5 0.32000
+//     4-5 0.35000  replaced by 4-7 0.37000
+//     6-2 0.40000  replaced by 3-6 0.52000
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/handane123/algorithms/digraph"
+	"github.com/handane123/algorithms/io/stdin"
+)
+
+func main() {
+	in := stdin.NewInFileWords(os.Args[1])
+	G := digraph.NewEdgeWeightedGraphIn(in)
+	s := digraph.NewMSTSensitivity(G)
+	msf := s.Forest()
+
+	fmt.Printf("%d trees, weight %.5f\n", msf.Count(), msf.Weight())
+	for id := 0; id < msf.Count(); id++ {
+		fmt.Printf("tree %d (%.5f):", id, msf.TreeWeight(id))
+		for _, v := range msf.Vertices(id) {
+			fmt.Print(" ", v)
+		}
+		fmt.Println()
+		for _, e := range msf.Tree(id) {
+			if r := s.Replacement(e); r != nil {
+				fmt.Printf("  %v  replaced by %v\n", e, r)
+			} else {
+				fmt.Printf("  %v  bridge\n", e)
+			}
+		}
+	}
+}
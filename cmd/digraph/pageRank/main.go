@@ -0,0 +1,49 @@
+package main
+
+//   Rank the vertices of a symbol digraph by PageRank and print the top k.
+//   An optional fourth argument sets the damping factor (default 0.85).
+
+//   % go run main.go routes.txt " " 3
+//   LAX 0.19510
+//   MCO 0.18706
+//   PHX 0.13764
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/handane123/algorithms/digraph"
+)
+
+const usage = "usage: pageRank filename delimiter k [damping]"
+
+func main() {
+	if len(os.Args) < 4 || len(os.Args) > 5 {
+		exitUsage()
+	}
+	filename := os.Args[1]
+	delimiter := os.Args[2]
+	k, err := strconv.Atoi(os.Args[3])
+	if err != nil || k < 0 {
+		exitUsage()
+	}
+	damping := 0.85
+	if len(os.Args) > 4 {
+		damping, err = strconv.ParseFloat(os.Args[4], 64)
+		if err != nil || damping < 0 || damping >= 1 {
+			exitUsage()
+		}
+	}
+
+	sg := digraph.NewSymbolDigraph(filename, delimiter)
+	pr := digraph.NewPageRank(sg.Digraph(), damping, 1e-10, digraph.DanglingTeleport)
+	for _, v := range pr.Top(k) {
+		fmt.Printf("%s %.5f\n", sg.NameOf(v), pr.Rank(v))
+	}
+}
+
+func exitUsage() {
+	fmt.Fprintln(os.Stderr, usage)
+	os.Exit(2)
+}
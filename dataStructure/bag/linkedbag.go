@@ -25,6 +25,18 @@ func (b *Bag) Add(element interface{}) {
 	b.n++
 }
 
+// Remove removes the first occurrence of the item from this bag, and returns true if it was in the bag.
+func (b *Bag) Remove(element interface{}) bool {
+	for x := &b.first; *x != nil; x = &(*x).next {
+		if (*x).item == element {
+			*x = (*x).next
+			b.n--
+			return true
+		}
+	}
+	return false
+}
+
 // IsEmpty check whether the bag is empty.
 func (b *Bag) IsEmpty() bool {
 	return b.first == nil
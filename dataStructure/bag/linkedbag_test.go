@@ -32,6 +32,23 @@ func TestBag_Values(t *testing.T) {
 	}
 }
 
+func TestBag_Remove(t *testing.T) {
+	s := New()
+	for i := 1; i <= 3; i++ {
+		s.Add(i)
+		s.Add(i)
+	}
+	if !s.Remove(2) || !s.Remove(3) || s.Remove(4) {
+		t.Errorf("expect to remove 2 and 3 but not 4")
+	}
+	got := s.Values()
+	expect := []interface{}{3, 2, 1, 1}
+
+	if !reflect.DeepEqual(got, expect) || s.Size() != 4 {
+		t.Errorf("Expect: %v, Got: %v", expect, got)
+	}
+}
+
 func benchmarkAdd(b *testing.B, bag *Bag, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -21,7 +21,7 @@ type AcyclicLP struct {
 }
 
 // NewAcyclicLP computes a longest paths tree from s to every other vertex in the directed acyclic graph G.
-func NewAcyclicLP(G *EdgeWeightedDigraph, s int) (*AcyclicLP, error) {
+func NewAcyclicLP(G EdgeWeightedDigraphView, s int) (*AcyclicLP, error) {
 	lp := &AcyclicLP{
 		distTo: make([]float64, G.V()),
 		edgeTo: make([]*DirectedEdge, G.V()),
@@ -20,7 +20,7 @@ type AcyclicSP struct {
 }
 
 // NewAcyclicSP computes a shortest paths tree from s to every other vertex in the directed acyclic graph G.
-func NewAcyclicSP(G *EdgeWeightedDigraph, s int) (*AcyclicSP, error) {
+func NewAcyclicSP(G EdgeWeightedDigraphView, s int) (*AcyclicSP, error) {
 	sp := &AcyclicSP{
 		distTo: make([]float64, G.V()),
 		edgeTo: make([]*DirectedEdge, G.V()),
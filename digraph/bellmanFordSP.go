@@ -26,7 +26,7 @@ type BellmanFordSP struct {
 }
 
 // NewBellmanFordSP computes a shortest paths tree from s to every other vertex in the edge-weighted digraph G.
-func NewBellmanFordSP(G *EdgeWeightedDigraph, s int) *BellmanFordSP {
+func NewBellmanFordSP(G EdgeWeightedDigraphView, s int) *BellmanFordSP {
 	sp := &BellmanFordSP{
 		distTo:  make([]float64, G.V()),
 		edgeTo:  make([]*DirectedEdge, G.V()),
@@ -50,7 +50,7 @@ func NewBellmanFordSP(G *EdgeWeightedDigraph, s int) *BellmanFordSP {
 }
 
 // relax vertex v and put other endpoints on queue if changed
-func (sp *BellmanFordSP) relax(G *EdgeWeightedDigraph, v int) {
+func (sp *BellmanFordSP) relax(G EdgeWeightedDigraphView, v int) {
 	for _, e := range G.Adj(v) {
 		w := e.To()
 		if sp.distTo[w] > sp.distTo[v]+e.Weight() {
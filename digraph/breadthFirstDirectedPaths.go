@@ -24,7 +24,7 @@ type BreadthFirstDirectedPaths struct {
 
 // NewBreadthFirstDirectedPaths computes the shortest path between
 // the source vertex s and every other vertex in the graph G.
-func NewBreadthFirstDirectedPaths(G *Digraph, s int) *BreadthFirstDirectedPaths {
+func NewBreadthFirstDirectedPaths(G DigraphView, s int) *BreadthFirstDirectedPaths {
 	bfp := &BreadthFirstDirectedPaths{
 		marked: make([]bool, G.V()),
 		edgeTo: make([]int, G.V()),
@@ -40,7 +40,7 @@ func NewBreadthFirstDirectedPaths(G *Digraph, s int) *BreadthFirstDirectedPaths
 
 // NewBreadthFirstDirectedPathSources computes the shortest path from any one of
 // the source vertices in sources to every other vertex in graph G.
-func NewBreadthFirstDirectedPathSources(G *Digraph, sources []int) *BreadthFirstDirectedPaths {
+func NewBreadthFirstDirectedPathSources(G DigraphView, sources []int) *BreadthFirstDirectedPaths {
 	bfp := &BreadthFirstDirectedPaths{
 		marked: make([]bool, G.V()),
 		edgeTo: make([]int, G.V()),
@@ -54,7 +54,7 @@ func NewBreadthFirstDirectedPathSources(G *Digraph, sources []int) *BreadthFirst
 	return bfp
 }
 
-func (bfp *BreadthFirstDirectedPaths) bfsSources(G *Digraph, sources []int) {
+func (bfp *BreadthFirstDirectedPaths) bfsSources(G DigraphView, sources []int) {
 	q := arrayqueue.New()
 	for _, s := range sources {
 		bfp.marked[s] = true
@@ -76,7 +76,7 @@ func (bfp *BreadthFirstDirectedPaths) bfsSources(G *Digraph, sources []int) {
 	}
 }
 
-func (bfp *BreadthFirstDirectedPaths) bfs(G *Digraph, s int) {
+func (bfp *BreadthFirstDirectedPaths) bfs(G DigraphView, s int) {
 	q := arrayqueue.New()
 	bfp.distTo[s] = 0
 	bfp.marked[s] = true
@@ -0,0 +1,262 @@
+package digraph
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/handane123/algorithms/dataStructure/priorityqueue"
+)
+
+// Centrality struct represents a data type for ranking the vertices of a digraph or an edge-weighted digraph
+// by betweenness, closeness and harmonic centrality, measured along directed paths.
+// The betweenness of v is the sum, over all ordered pairs of vertices s and t other than v, of the fraction of
+// shortest s->t paths that pass through v.
+// The closeness of v is (r - 1) / d, where r is the number of vertices reachable from v (including v)
+// and d is the sum of their distances from v; it is 0 if v reaches no other vertex.
+// The harmonic centrality of v is the sum of 1 / dist(v, w) over all vertices w != v reachable from v.
+// This implementation uses Brandes' algorithm, with a breadth-first search from every vertex for a digraph
+// and Dijkstra's algorithm from every vertex for an edge-weighted digraph with positive weights. The sources can be divided
+// among several goroutines. The constructor takes O(V E) time for a digraph and O(V E log V) time
+// for an edge-weighted digraph, where V is the number of vertices and E is the number of edges.
+// Each instance method takes O(1) time. It uses O(V + E) extra space per goroutine (not including the digraph).
+type Centrality struct {
+	betweenness []float64
+	closeness   []float64
+	harmonic    []float64
+}
+
+// NewCentrality computes the centrality of every vertex in the digraph G, where every edge has length 1,
+// running the searches on the given number of goroutines; workers less than 2 runs them sequentially.
+func NewCentrality(G *Digraph, workers int) *Centrality {
+	adj := make([][]*DirectedEdge, G.V())
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			adj[v] = append(adj[v], NewDirectedEdge(v, w, 1.0))
+		}
+	}
+	return newCentrality(adj, workers, (*brandes).bfs)
+}
+
+// NewCentralityEWD computes the centrality of every vertex in the edge-weighted digraph G, where the edge weights
+// are positive, running the searches on the given number of goroutines; workers less than 2 runs them sequentially.
+// It panics on an edge of weight zero or less: Dijkstra's algorithm settles the vertices at equal distance in an
+// arbitrary order, so with edges of weight zero it could settle a vertex before some of its predecessors on
+// shortest paths, and a cycle of weight zero makes the number of shortest paths infinite.
+// Path lengths within a relative tolerance of 1e-10 of each other count as equal, so paths of equal length whose
+// sums of weights round differently are all shortest paths.
+func NewCentralityEWD(G *EdgeWeightedDigraph, workers int) *Centrality {
+	adj := make([][]*DirectedEdge, G.V())
+	for v := 0; v < G.V(); v++ {
+		adj[v] = G.Adj(v)
+		for _, e := range adj[v] {
+			if e.Weight() <= 0 {
+				panic(fmt.Sprintln("edge ", e, " has nonpositive weight"))
+			}
+		}
+	}
+	return newCentrality(adj, workers, (*brandes).dijkstra)
+}
+
+func newCentrality(adj [][]*DirectedEdge, workers int, sssp func(*brandes, [][]*DirectedEdge, int)) *Centrality {
+	V := len(adj)
+	c := &Centrality{
+		betweenness: make([]float64, V),
+		closeness:   make([]float64, V),
+		harmonic:    make([]float64, V),
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > V && V > 0 {
+		workers = V
+	}
+
+	// worker k handles the sources k, k + workers, k + 2 workers, ...
+	partial := make([][]float64, workers)
+	var wg sync.WaitGroup
+	for k := 0; k < workers; k++ {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			b := newBrandes(V)
+			for s := k; s < V; s += workers {
+				sssp(b, adj, s)
+				c.closeness[s], c.harmonic[s] = b.distances()
+				b.accumulate(s)
+			}
+			partial[k] = b.betweenness
+		}(k)
+	}
+	wg.Wait()
+
+	// sum in a fixed order so that the result does not depend on scheduling
+	for _, p := range partial {
+		for v := range p {
+			c.betweenness[v] += p[v]
+		}
+	}
+	return c
+}
+
+// Betweenness returns the betweenness centrality of vertex v.
+func (c *Centrality) Betweenness(v int) float64 {
+	c.validateVertex(v)
+	return c.betweenness[v]
+}
+
+// Closeness returns the closeness centrality of vertex v.
+func (c *Centrality) Closeness(v int) float64 {
+	c.validateVertex(v)
+	return c.closeness[v]
+}
+
+// Harmonic returns the harmonic centrality of vertex v.
+func (c *Centrality) Harmonic(v int) float64 {
+	c.validateVertex(v)
+	return c.harmonic[v]
+}
+
+func (c *Centrality) validateVertex(v int) {
+	V := len(c.betweenness)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
+
+// brandes holds the per-source state of Brandes' algorithm, reused across sources.
+type brandes struct {
+	order       []int     // vertices in nondecreasing order of distance from the source
+	preds       [][]int   // preds[w] = predecessors of w on shortest paths from the source
+	sigma       []float64 // sigma[w] = number of shortest paths from the source to w
+	dist        []double  // dist[w] = distance from the source to w, math.MaxFloat64 if unreachable
+	delta       []float64 // delta[w] = dependency of the source on w
+	settled     []bool    // settled[w] = has w been added to order?
+	pq          *priorityqueue.IndexMinPQ
+	betweenness []float64 // betweenness accumulated over the sources handled so far
+}
+
+func newBrandes(V int) *brandes {
+	b := &brandes{
+		preds:       make([][]int, V),
+		sigma:       make([]float64, V),
+		dist:        make([]double, V),
+		delta:       make([]float64, V),
+		settled:     make([]bool, V),
+		pq:          priorityqueue.NewIndexMinPQ(V),
+		betweenness: make([]float64, V),
+	}
+	for v := range b.dist {
+		b.dist[v] = math.MaxFloat64
+	}
+	return b
+}
+
+// clears the state left by the previous source and starts from s
+func (b *brandes) reset(s int) {
+	for _, v := range b.order {
+		b.preds[v] = b.preds[v][:0]
+		b.sigma[v] = 0
+		b.dist[v] = math.MaxFloat64
+		b.delta[v] = 0
+		b.settled[v] = false
+	}
+	b.order = b.order[:0]
+	b.sigma[s] = 1
+	b.dist[s] = 0
+}
+
+// breadth-first search from s, counting the shortest paths to every vertex
+func (b *brandes) bfs(adj [][]*DirectedEdge, s int) {
+	b.reset(s)
+	b.order = append(b.order, s)
+	b.settled[s] = true
+	// b.order doubles as the queue
+	for head := 0; head < len(b.order); head++ {
+		v := b.order[head]
+		for _, e := range adj[v] {
+			w := e.To()
+			if !b.settled[w] {
+				b.settled[w] = true
+				b.dist[w] = b.dist[v] + 1
+				b.order = append(b.order, w)
+			}
+			if b.dist[w] == b.dist[v]+1 {
+				b.sigma[w] += b.sigma[v]
+				b.preds[w] = append(b.preds[w], v)
+			}
+		}
+	}
+}
+
+// Dijkstra's algorithm from s, counting the shortest paths to every vertex
+func (b *brandes) dijkstra(adj [][]*DirectedEdge, s int) {
+	b.reset(s)
+	//nolint:errcheck
+	b.pq.Insert(s, b.dist[s])
+	for !b.pq.IsEmpty() {
+		v, _ := b.pq.DelMin()
+		b.settled[v] = true
+		b.order = append(b.order, v)
+		for _, e := range adj[v] {
+			w := e.To()
+			// with positive weights, a settled w is nearer than d, so v is not its predecessor
+			if b.settled[w] {
+				continue
+			}
+			d := b.dist[v] + double(e.Weight())
+			if tied(d, b.dist[w]) {
+				b.sigma[w] += b.sigma[v]
+				b.preds[w] = append(b.preds[w], v)
+			} else if d < b.dist[w] {
+				b.dist[w] = d
+				b.sigma[w] = b.sigma[v]
+				b.preds[w] = append(b.preds[w][:0], v)
+				if b.pq.Contains(w) {
+					//nolint:errcheck
+					b.pq.DecreaseKey(w, d)
+				} else {
+					//nolint:errcheck
+					b.pq.Insert(w, d)
+				}
+			}
+		}
+	}
+}
+
+// relative tolerance within which path lengths count as equal
+const tieTolerance = 1e-10
+
+// returns true if the path length d equals the distance dist up to rounding
+func tied(d, dist double) bool {
+	return math.Abs(float64(d-dist)) <= tieTolerance*float64(d)
+}
+
+// returns the closeness and harmonic centrality of the last source
+func (b *brandes) distances() (closeness, harmonic float64) {
+	total := 0.0
+	for _, v := range b.order[1:] {
+		d := float64(b.dist[v])
+		total += d
+		if d > 0 {
+			harmonic += 1.0 / d
+		}
+	}
+	if total > 0 {
+		closeness = float64(len(b.order)-1) / total
+	}
+	return closeness, harmonic
+}
+
+// back-propagates the dependencies of the last source s in order of nonincreasing distance
+func (b *brandes) accumulate(s int) {
+	for i := len(b.order) - 1; i >= 0; i-- {
+		w := b.order[i]
+		for _, v := range b.preds[w] {
+			b.delta[v] += b.sigma[v] / b.sigma[w] * (1 + b.delta[w])
+		}
+		if w != s {
+			b.betweenness[w] += b.delta[w]
+		}
+	}
+}
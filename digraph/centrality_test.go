@@ -0,0 +1,103 @@
+package digraph
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/handane123/algorithms/io/stdin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCentrality(t *testing.T) {
+	assert := assert.New(t)
+
+	// 0->1->2->3
+	G := NewDigraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 3)
+
+	c := NewCentrality(G, 1)
+	assert.Equal(0.0, c.Betweenness(0))
+	assert.Equal(2.0, c.Betweenness(1))
+	assert.Equal(2.0, c.Betweenness(2))
+	assert.Equal(0.0, c.Betweenness(3))
+	assert.Equal(0.5, c.Closeness(0))
+	assert.Equal(0.0, c.Closeness(3))
+	assert.Equal(1.5, c.Harmonic(1))
+	assert.Panics(func() { c.Closeness(-1) })
+}
+
+func TestCentralityEWD(t *testing.T) {
+	assert := assert.New(t)
+
+	// two shortest paths from 0 to 2, one through 1
+	G := NewEdgeWeightedDigraphV(3)
+	G.AddEdge(NewDirectedEdge(0, 1, 1.0))
+	G.AddEdge(NewDirectedEdge(1, 2, 1.0))
+	G.AddEdge(NewDirectedEdge(0, 2, 2.0))
+
+	c := NewCentralityEWD(G, 2)
+	assert.Equal(0.5, c.Betweenness(1))
+	assert.Equal(0.0, c.Betweenness(0))
+	assert.Equal(2.0/3.0, c.Closeness(0))
+	assert.Equal(1.5, c.Harmonic(0))
+
+	tinyEWD := "8\n" +
+		"15\n" +
+		"4 5 0.35\n" +
+		"5 4 0.35\n" +
+		"4 7 0.37\n" +
+		"5 7 0.28\n" +
+		"7 5 0.28\n" +
+		"5 1 0.32\n" +
+		"0 4 0.38\n" +
+		"0 2 0.26\n" +
+		"7 3 0.39\n" +
+		"1 3 0.29\n" +
+		"2 7 0.34\n" +
+		"6 2 0.40\n" +
+		"3 6 0.52\n" +
+		"6 0 0.58\n" +
+		"6 4 0.93\n"
+	buf := strings.NewReader(tinyEWD)
+	scanner := bufio.NewScanner(buf)
+	scanner.Split(bufio.ScanWords)
+	in := &stdin.In{Scanner: scanner}
+	G1 := NewEdgeWeightedDigraphIn(in)
+
+	seq := NewCentralityEWD(G1, 1)
+	par := NewCentralityEWD(G1, 3)
+	all := NewDijkstraAllPairsSP(G1)
+	for v := 0; v < G1.V(); v++ {
+		assert.InDelta(seq.Betweenness(v), par.Betweenness(v), 1e-9)
+		assert.Equal(seq.Closeness(v), par.Closeness(v))
+
+		total := 0.0
+		for w := 0; w < G1.V(); w++ {
+			total += all.Dist(v, w)
+		}
+		assert.InEpsilon(float64(G1.V()-1)/total, seq.Closeness(v), 1e-9)
+	}
+
+	G1.AddEdge(NewDirectedEdge(0, 1, -1.0))
+	assert.Panics(func() { NewCentralityEWD(G1, 1) })
+
+	// 0.1 + 0.2 and 0.25 + 0.05 round differently, but both paths from 0 to 3 are shortest
+	G3 := NewEdgeWeightedDigraphV(4)
+	G3.AddEdge(NewDirectedEdge(0, 1, 0.1))
+	G3.AddEdge(NewDirectedEdge(1, 3, 0.2))
+	G3.AddEdge(NewDirectedEdge(0, 2, 0.25))
+	G3.AddEdge(NewDirectedEdge(2, 3, 0.05))
+	c = NewCentralityEWD(G3, 1)
+	assert.Equal(0.5, c.Betweenness(1))
+	assert.Equal(0.5, c.Betweenness(2))
+
+	// with a zero weight edge the count of shortest paths would depend on the order of ties
+	G2 := NewEdgeWeightedDigraphV(3)
+	G2.AddEdge(NewDirectedEdge(0, 1, 1.0))
+	G2.AddEdge(NewDirectedEdge(0, 2, 1.0))
+	G2.AddEdge(NewDirectedEdge(1, 2, 0.0))
+	assert.Panics(func() { NewCentralityEWD(G2, 1) })
+}
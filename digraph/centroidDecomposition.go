@@ -0,0 +1,202 @@
+package digraph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CentroidDecomposition struct represents a data type for counting the vertices of a tree within a given
+// distance of a vertex, and the pairs of vertices within a given distance of each other, where the distance is
+// the total weight of the path between them. The edge weights must be nonnegative, and the constructor panics if
+// the graph is not a tree.
+// This implementation uses centroid decomposition: removing a centroid, a vertex whose removal leaves components
+// of at most half the size, and decomposing the components recursively gives a centroid tree of depth O(log V)
+// in which every path of the tree passes through the lowest common centroid ancestor of its endpoints. Every
+// centroid keeps the sorted distances to the vertices of its component, and to the same vertices from its parent
+// centroid, to discount the vertices counted twice.
+// The constructor takes O(V log^2 V) time, CountWithin takes O(log^2 V) time, CountPairsWithin takes
+// O(V log^2 V) time, Distance takes O(log V) time, and the other instance methods take O(1) time.
+// It uses O(V log V) extra space (not including the graph).
+type CentroidDecomposition struct {
+	parent   []int       // parent[c] = parent of c in the centroid tree, -1 for the root
+	level    []int       // level[c] = depth of c in the centroid tree
+	dist     [][]float64 // dist[v][i] = distance from v to its centroid ancestor at level i
+	below    [][]float64 // below[c] = sorted distances from c to the vertices of its component
+	toParent [][]float64 // toParent[c] = sorted distances from the parent of c to the vertices of the component of c
+}
+
+// NewCentroidDecomposition computes the centroid decomposition of the tree G.
+func NewCentroidDecomposition(G *EdgeWeightedGraph) *CentroidDecomposition {
+	V := G.V()
+	if V > 0 {
+		treeOrder(G, 0)
+	}
+	for _, e := range G.Edges() {
+		if e.Weight() < 0 {
+			panic(fmt.Sprintln("edge ", e, " has negative weight"))
+		}
+	}
+	cd := &CentroidDecomposition{
+		parent:   make([]int, V),
+		level:    make([]int, V),
+		dist:     make([][]float64, V),
+		below:    make([][]float64, V),
+		toParent: make([][]float64, V),
+	}
+	adj := make([][]*Edge, V)
+	for v := 0; v < V; v++ {
+		adj[v] = G.Adj(v)
+	}
+	removed := make([]bool, V)
+	size := make([]int, V)
+	from := make([]int, V) // from[v] = vertex through which v was reached in the current component
+
+	// each task decomposes the component containing a vertex below a given parent centroid
+	type task struct{ start, parent int }
+	var tasks []task
+	if V > 0 {
+		tasks = append(tasks, task{0, -1})
+	}
+	for len(tasks) > 0 {
+		t := tasks[len(tasks)-1]
+		tasks = tasks[:len(tasks)-1]
+
+		// find the vertices of the component and the sizes of their subtrees
+		component := []int{t.start}
+		from[t.start] = -1
+		for i := 0; i < len(component); i++ {
+			v := component[i]
+			for _, e := range adj[v] {
+				if w := e.Other(v); !removed[w] && w != from[v] {
+					from[w] = v
+					component = append(component, w)
+				}
+			}
+		}
+		for i := len(component) - 1; i >= 0; i-- {
+			v := component[i]
+			size[v] = 1
+			for _, e := range adj[v] {
+				if w := e.Other(v); !removed[w] && w != from[v] {
+					size[v] += size[w]
+				}
+			}
+		}
+
+		// walk towards the larger side until no side is larger than half the component
+		c := t.start
+		for moved := true; moved; {
+			moved = false
+			for _, e := range adj[c] {
+				if w := e.Other(c); !removed[w] && w != from[c] && 2*size[w] > len(component) {
+					c = w
+					moved = true
+					break
+				}
+			}
+		}
+
+		cd.parent[c] = t.parent
+		if t.parent >= 0 {
+			cd.level[c] = cd.level[t.parent] + 1
+		}
+		level := cd.level[c]
+		if t.parent >= 0 {
+			for _, v := range component {
+				cd.toParent[c] = append(cd.toParent[c], cd.dist[v][level-1])
+			}
+			sort.Float64s(cd.toParent[c])
+		}
+
+		// distances from the centroid, in the order of a search from it
+		cd.dist[c] = append(cd.dist[c], 0)
+		reached := []int{c}
+		from[c] = -1
+		for i := 0; i < len(reached); i++ {
+			v := reached[i]
+			cd.below[c] = append(cd.below[c], cd.dist[v][level])
+			for _, e := range adj[v] {
+				if w := e.Other(v); !removed[w] && w != from[v] {
+					from[w] = v
+					cd.dist[w] = append(cd.dist[w], cd.dist[v][level]+e.Weight())
+					reached = append(reached, w)
+				}
+			}
+		}
+		sort.Float64s(cd.below[c])
+
+		removed[c] = true
+		for _, e := range adj[c] {
+			if w := e.Other(c); !removed[w] {
+				tasks = append(tasks, task{w, c})
+			}
+		}
+	}
+	return cd
+}
+
+// returns the number of the sorted values that are at most d
+func countAtMost(sorted []float64, d float64) int {
+	return sort.Search(len(sorted), func(i int) bool { return sorted[i] > d })
+}
+
+// CountWithin returns the number of vertices at distance at most d from vertex v, including v itself if d
+// is nonnegative.
+func (cd *CentroidDecomposition) CountWithin(v int, d float64) int {
+	cd.validateVertex(v)
+	count := countAtMost(cd.below[v], d)
+	for child, c := v, cd.parent[v]; c >= 0; child, c = c, cd.parent[c] {
+		r := d - cd.dist[v][cd.level[c]]
+		count += countAtMost(cd.below[c], r) - countAtMost(cd.toParent[child], r)
+	}
+	return count
+}
+
+// CountPairsWithin returns the number of unordered pairs of distinct vertices at distance at most d.
+func (cd *CentroidDecomposition) CountPairsWithin(d float64) int {
+	if d < 0 {
+		return 0
+	}
+	count := 0
+	for v := range cd.parent {
+		count += cd.CountWithin(v, d) - 1
+	}
+	return count / 2
+}
+
+// Distance returns the total weight of the path between vertices v and w.
+func (cd *CentroidDecomposition) Distance(v, w int) float64 {
+	cd.validateVertex(v)
+	cd.validateVertex(w)
+	// the path passes through the deepest common centroid ancestor
+	c, x := v, w
+	for cd.level[c] > cd.level[x] {
+		c = cd.parent[c]
+	}
+	for cd.level[x] > cd.level[c] {
+		x = cd.parent[x]
+	}
+	for c != x {
+		c, x = cd.parent[c], cd.parent[x]
+	}
+	return cd.dist[v][cd.level[c]] + cd.dist[w][cd.level[c]]
+}
+
+// Parent returns the parent of vertex v in the centroid tree, -1 if v is its root.
+func (cd *CentroidDecomposition) Parent(v int) int {
+	cd.validateVertex(v)
+	return cd.parent[v]
+}
+
+// Level returns the depth of vertex v in the centroid tree, 0 for its root.
+func (cd *CentroidDecomposition) Level(v int) int {
+	cd.validateVertex(v)
+	return cd.level[v]
+}
+
+func (cd *CentroidDecomposition) validateVertex(v int) {
+	V := len(cd.parent)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
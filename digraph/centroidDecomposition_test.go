@@ -0,0 +1,86 @@
+package digraph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCentroidDecomposition(t *testing.T) {
+	assert := assert.New(t)
+
+	// path 0-1-2-3-4-5-6 with unit weights: 3 is the centroid
+	G := NewEdgeWeightedGraphV(7)
+	for v := 0; v < 6; v++ {
+		G.AddEdge(NewEdge(v, v+1, 1))
+	}
+	cd := NewCentroidDecomposition(G)
+	assert.Equal(-1, cd.Parent(3))
+	assert.Equal(0, cd.Level(3))
+	assert.Equal(2, cd.Level(0))
+	assert.Equal(4, cd.CountWithin(0, 3))
+	assert.Equal(7, cd.CountWithin(3, 3))
+	assert.Equal(0, cd.CountWithin(3, -1))
+	assert.Equal(6, cd.CountPairsWithin(1))
+	assert.Equal(21, cd.CountPairsWithin(6))
+	assert.Equal(5.0, cd.Distance(6, 1))
+
+	assert.Equal(0, NewCentroidDecomposition(NewEdgeWeightedGraphV(0)).CountPairsWithin(1))
+	assert.Equal(1, NewCentroidDecomposition(NewEdgeWeightedGraphV(1)).CountWithin(0, 0))
+	assert.Panics(func() { cd.CountWithin(7, 0) })
+	G.AddEdge(NewEdge(0, 6, 1))
+	assert.Panics(func() { NewCentroidDecomposition(G) })
+	H := NewEdgeWeightedGraphV(2)
+	H.AddEdge(NewEdge(0, 1, -1))
+	assert.Panics(func() { NewCentroidDecomposition(H) })
+}
+
+func TestCentroidDecompositionRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(40))
+	for trial := 0; trial < 10; trial++ {
+		V := 1 + r.Intn(150)
+		G, parent := randomWeightedTree(r, V)
+		weight := make(map[[2]int]float64)
+		for _, e := range G.Edges() {
+			v := e.Either()
+			weight[[2]int{v, e.Other(v)}] = e.Weight()
+			weight[[2]int{e.Other(v), v}] = e.Weight()
+		}
+		dist := make([][]float64, V)
+		for v := range dist {
+			dist[v] = make([]float64, V)
+			for w := range dist[v] {
+				path := treePath(parent, v, w)
+				for j := 1; j < len(path); j++ {
+					dist[v][w] += weight[[2]int{path[j-1], path[j]}]
+				}
+			}
+		}
+
+		cd := NewCentroidDecomposition(G)
+		for v := 0; v < V; v++ {
+			// the centroid tree is balanced
+			assert.True(1<<cd.Level(v) <= V)
+			for w := 0; w < V; w++ {
+				assert.Equal(dist[v][w], cd.Distance(v, w))
+			}
+		}
+		for i := 0; i < 20; i++ {
+			d := float64(r.Intn(60))
+			pairs := 0
+			for v := 0; v < V; v++ {
+				count := 0
+				for w := 0; w < V; w++ {
+					if dist[v][w] <= d {
+						count++
+					}
+				}
+				assert.Equal(count, cd.CountWithin(v, d))
+				pairs += count - 1
+			}
+			assert.Equal(pairs/2, cd.CountPairsWithin(d))
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package digraph
+
+import "github.com/handane123/algorithms/graph"
+
+// The conversions below build a new graph of another kind on the same vertices, leaving the original unchanged.
+// A directed form has an edge in each direction for every undirected edge, and a single edge for a self-loop;
+// an undirected form has an edge for every directed edge, so the edges v->w and w->v become parallel edges.
+// Weighting an unweighted graph takes a function giving the weight of every edge, and dropping the weights keeps
+// the edges and their order. Each conversion takes O(E + V) time.
+
+// NewDigraphGraph returns the directed form of the graph G.
+func NewDigraphGraph(G *graph.Graph) *Digraph {
+	D := NewDigraph(G.V())
+	for v := 0; v < G.V(); v++ {
+		selfLoops := 0
+		for _, w := range G.Adj(v) {
+			// a self loop is in the list twice
+			if w == v {
+				selfLoops++
+			}
+			if w != v || selfLoops%2 == 1 {
+				D.AddEdge(v, w)
+			}
+		}
+	}
+	return D
+}
+
+// NewEdgeWeightedGraphGraph returns the graph G with the weight weight(v, w) on every edge v-w, v <= w.
+func NewEdgeWeightedGraphGraph(G *graph.Graph, weight func(v, w int) float64) *EdgeWeightedGraph {
+	W := NewEdgeWeightedGraphV(G.V())
+	for v := 0; v < G.V(); v++ {
+		selfLoops := 0
+		for _, w := range G.Adj(v) {
+			// a self loop is in the list twice
+			if w == v {
+				selfLoops++
+			}
+			if w > v || w == v && selfLoops%2 == 1 {
+				W.AddEdge(NewEdge(v, w, weight(v, w)))
+			}
+		}
+	}
+	return W
+}
+
+// Undirected returns the undirected form of this digraph.
+func (dg *Digraph) Undirected() *graph.Graph {
+	G := graph.NewGraph(dg.v)
+	for _, e := range dg.edges() {
+		G.AddEdge(e[0], e[1])
+	}
+	return G
+}
+
+// Weighted returns this digraph with the weight weight(v, w) on every edge v->w.
+func (dg *Digraph) Weighted(weight func(v, w int) float64) *EdgeWeightedDigraph {
+	W := NewEdgeWeightedDigraphV(dg.v)
+	for v := 0; v < dg.v; v++ {
+		// in reverse, as adding edges reverses the adjacency lists
+		values := dg.adj[v].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			w := values[i].(int)
+			W.AddEdge(NewDirectedEdge(v, w, weight(v, w)))
+		}
+	}
+	return W
+}
+
+// Directed returns the directed form of this edge-weighted graph, each edge with the weight of its undirected edge.
+func (wg *EdgeWeightedGraph) Directed() *EdgeWeightedDigraph {
+	D := NewEdgeWeightedDigraphV(wg.v)
+	for _, e := range wg.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		D.AddEdge(NewDirectedEdge(v, w, e.Weight()))
+		if w != v {
+			D.AddEdge(NewDirectedEdge(w, v, e.Weight()))
+		}
+	}
+	return D
+}
+
+// Unweighted returns this edge-weighted graph without its weights.
+func (wg *EdgeWeightedGraph) Unweighted() *graph.Graph {
+	G := graph.NewGraph(wg.v)
+	for _, e := range wg.Edges() {
+		v := e.Either()
+		G.AddEdge(v, e.Other(v))
+	}
+	return G
+}
+
+// Undirected returns the undirected form of this edge-weighted digraph, each edge with the weight of its
+// directed edge.
+func (wd *EdgeWeightedDigraph) Undirected() *EdgeWeightedGraph {
+	G := NewEdgeWeightedGraphV(wd.v)
+	for _, e := range wd.Edges() {
+		G.AddEdge(NewEdge(e.From(), e.To(), e.Weight()))
+	}
+	return G
+}
+
+// Unweighted returns this edge-weighted digraph without its weights.
+func (wd *EdgeWeightedDigraph) Unweighted() *Digraph {
+	D := NewDigraph(wd.v)
+	for v := 0; v < wd.v; v++ {
+		// in reverse, as adding edges reverses the adjacency lists
+		values := wd.adj[v].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			D.AddEdge(v, values[i].(*DirectedEdge).To())
+		}
+	}
+	return D
+}
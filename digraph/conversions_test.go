@@ -0,0 +1,63 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/handane123/algorithms/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDigraphGraph(t *testing.T) {
+	assert := assert.New(t)
+	G := graph.NewGraph(3)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 2)
+
+	D := NewDigraphGraph(G)
+	assert.Equal(5, D.E())
+	assert.ElementsMatch([]int{0, 2}, D.Adj(1))
+	assert.ElementsMatch([]int{1, 2}, D.Adj(2))
+
+	U := D.Undirected()
+	assert.Equal(5, U.E())
+	assert.ElementsMatch([]int{0, 0, 2, 2}, U.Adj(1))
+}
+
+func TestDigraph_Weighted(t *testing.T) {
+	assert := assert.New(t)
+	G := cycleDigraph(3)
+	G.AddEdge(0, 2)
+
+	W := G.Weighted(func(v, w int) float64 { return float64(10*v + w) })
+	assert.Equal(4, W.E())
+	assert.Equal(2.0, W.Adj(0)[0].Weight())
+	assert.Equal(1.0, W.Adj(0)[1].Weight())
+	assert.Equal(G.String(), W.Unweighted().String())
+}
+
+func TestEdgeWeightedGraph_Conversions(t *testing.T) {
+	assert := assert.New(t)
+	G := graph.NewGraph(3)
+	G.AddEdge(1, 0)
+	G.AddEdge(1, 2)
+	G.AddEdge(0, 0)
+
+	W := NewEdgeWeightedGraphGraph(G, func(v, w int) float64 { return float64(v + w) })
+	assert.Equal(3, W.E())
+	for _, e := range W.Edges() {
+		v := e.Either()
+		assert.Equal(float64(v+e.Other(v)), e.Weight())
+	}
+	assert.Equal(3, W.Unweighted().E())
+	assert.ElementsMatch([]int{0, 0, 1}, W.Unweighted().Adj(0))
+
+	D := W.Directed()
+	assert.Equal(5, D.E())
+	assert.Equal(2, D.InDegree(0))
+	assert.Equal(3.0, D.Adj(2)[0].Weight())
+
+	U := D.Undirected()
+	assert.Equal(5, U.E())
+	assert.Equal(4, U.Degree(1))
+}
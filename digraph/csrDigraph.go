@@ -0,0 +1,232 @@
+package digraph
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/handane123/algorithms/io/binaryout"
+	"github.com/pkg/errors"
+)
+
+// csrMagic starts every CSR digraph file.
+const csrMagic = "CSRD"
+
+// CSRDigraph struct represents an immutable digraph of vertices named 0 through V - 1 in compressed sparse row
+// form, for digraphs too large for the adjacency lists of Digraph. It supports the read-only queries of Digraph:
+// the number of vertices and edges, the vertices adjacent from a vertex and its outdegree, and implements
+// DigraphView, so the search, path, topological and strong-component types run on it.
+// This implementation stores the heads of the edges out of every vertex, in the order of Adj, contiguously in one
+// array of 32-bit vertices, and the offset of the edges of every vertex in another. It uses 4E + 8V bytes.
+// The binary format, written with BinaryOut, is big-endian: the magic CSRD, V and E as 64-bit ints, the offsets
+// of the edges of vertices 1 through V as 64-bit ints, and the heads of the edges as 32-bit ints. ReadCSRDigraph
+// loads it in one pass, validating it as it goes and growing its arrays as the data arrives, so a corrupted
+// header fails with an error rather than an oversized allocation.
+// Constructing a CSR digraph takes O(V + E) time. Adj(v) takes time proportional to the outdegree of v,
+// and the other instance methods take O(1) time.
+type CSRDigraph struct {
+	offsets []int   // edges out of v are heads[offsets[v]:offsets[v+1]]
+	heads   []int32 // heads of the edges
+}
+
+// NewCSRDigraph initializes a CSR digraph with the vertices and edges of G, in the order of its adjacency lists.
+func NewCSRDigraph(G *Digraph) *CSRDigraph {
+	if G.V() > math.MaxInt32 {
+		panic("number of vertices of a CSRDigraph must be at most 2^31 - 1")
+	}
+	c := &CSRDigraph{offsets: make([]int, G.V()+1), heads: make([]int32, 0, G.E())}
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			c.heads = append(c.heads, int32(w))
+		}
+		c.offsets[v+1] = len(c.heads)
+	}
+	return c
+}
+
+// NewCSRDigraphEdges initializes a CSR digraph with V vertices and an edge tails[i]->heads[i] for every i,
+// the edges out of every vertex in the order given, without building a Digraph first.
+func NewCSRDigraphEdges(V int, tails, heads []int) *CSRDigraph {
+	if V < 0 || V > math.MaxInt32 {
+		panic("number of vertices of a CSRDigraph must be between 0 and 2^31 - 1")
+	}
+	if len(tails) != len(heads) {
+		panic("tails and heads have different lengths")
+	}
+	c := &CSRDigraph{offsets: make([]int, V+1), heads: make([]int32, len(heads))}
+	for i := range tails {
+		c.validateVertex(tails[i])
+		c.validateVertex(heads[i])
+		c.offsets[tails[i]+1]++
+	}
+	for v := 0; v < V; v++ {
+		c.offsets[v+1] += c.offsets[v]
+	}
+	// counting sort of the edges by tail, stable
+	next := make([]int, V)
+	copy(next, c.offsets)
+	for i, v := range tails {
+		c.heads[next[v]] = int32(heads[i])
+		next[v]++
+	}
+	return c
+}
+
+// ReadCSRDigraph reads a CSR digraph written by WriteBinary from r in one pass.
+func ReadCSRDigraph(r io.Reader) (*CSRDigraph, error) {
+	in := bufio.NewReaderSize(r, 1<<16)
+	header := make([]byte, len(csrMagic)+16)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return nil, errors.Wrap(err, "reading header")
+	}
+	if string(header[:len(csrMagic)]) != csrMagic {
+		return nil, errors.New("not a CSR digraph")
+	}
+	V := int64(binary.BigEndian.Uint64(header[len(csrMagic):]))
+	E := int64(binary.BigEndian.Uint64(header[len(csrMagic)+8:]))
+	if V < 0 || V > math.MaxInt32 || E < 0 {
+		return nil, errors.Errorf("invalid number of vertices %d or edges %d", V, E)
+	}
+	// the heads of the edges must fit in memory, and the offsets in ints
+	if E > math.MaxInt32*V || uint64(E) > uint64(^uint(0)>>1)/4 {
+		return nil, errors.Errorf("too many edges %d for %d vertices", E, V)
+	}
+	// the arrays grow as the data arrives, so a truncated file claiming a large digraph fails before
+	// allocating it
+	c := &CSRDigraph{offsets: make([]int, 1, csrCapacity(V+1))}
+	buffer := make([]byte, 1<<16)
+	// reads n values of the given size, passing each to f
+	read := func(n int64, size int, f func(i int, x uint64) error) error {
+		for i := int64(0); i < n; {
+			chunk := int64(len(buffer) / size)
+			if n-i < chunk {
+				chunk = n - i
+			}
+			b := buffer[:chunk*int64(size)]
+			if _, err := io.ReadFull(in, b); err != nil {
+				return err
+			}
+			for j := 0; j < len(b); j += size {
+				var x uint64
+				if size == 8 {
+					x = binary.BigEndian.Uint64(b[j:])
+				} else {
+					x = uint64(binary.BigEndian.Uint32(b[j:]))
+				}
+				if err := f(int(i), x); err != nil {
+					return err
+				}
+				i++
+			}
+		}
+		return nil
+	}
+	err := read(V, 8, func(i int, x uint64) error {
+		offset := int64(x)
+		if offset < int64(c.offsets[i]) || offset > E {
+			return errors.Errorf("invalid offset %d of vertex %d", offset, i+1)
+		}
+		c.offsets = append(c.offsets, int(offset))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading offsets")
+	}
+	if int64(c.offsets[V]) != E {
+		return nil, errors.Errorf("offsets end at %d instead of %d edges", c.offsets[V], E)
+	}
+	c.heads = make([]int32, 0, csrCapacity(E))
+	err = read(E, 4, func(i int, x uint64) error {
+		if int64(x) >= V {
+			return errors.Errorf("invalid head %d of edge %d", x, i)
+		}
+		c.heads = append(c.heads, int32(x))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading edges")
+	}
+	return c, nil
+}
+
+// returns the initial capacity of an array of n values read from a file, at most a chunk
+func csrCapacity(n int64) int {
+	if n > 1<<16 {
+		return 1 << 16
+	}
+	return int(n)
+}
+
+// WriteBinary writes this CSR digraph to w in its binary format.
+func (c *CSRDigraph) WriteBinary(w io.Writer) error {
+	buffered := bufio.NewWriterSize(w, 1<<16)
+	out := binaryout.NewBinaryOut(buffered)
+	if err := out.WriteString(csrMagic); err != nil {
+		return err
+	}
+	if err := out.WriteInt64(int64(c.V())); err != nil {
+		return err
+	}
+	if err := out.WriteInt64(int64(c.E())); err != nil {
+		return err
+	}
+	for _, offset := range c.offsets[1:] {
+		if err := out.WriteInt64(int64(offset)); err != nil {
+			return err
+		}
+	}
+	for _, head := range c.heads {
+		if err := out.WriteInt(int(head)); err != nil {
+			return err
+		}
+	}
+	out.Close()
+	return buffered.Flush()
+}
+
+// V returns the number of vertices in this digraph.
+func (c *CSRDigraph) V() int {
+	return len(c.offsets) - 1
+}
+
+// E returns the number of edges in this digraph.
+func (c *CSRDigraph) E() int {
+	return len(c.heads)
+}
+
+// Adj returns the vertices adjacent from vertex v in this digraph.
+func (c *CSRDigraph) Adj(v int) (vertices []int) {
+	c.validateVertex(v)
+	vertices = make([]int, c.OutDegree(v))
+	for i, w := range c.heads[c.offsets[v]:c.offsets[v+1]] {
+		vertices[i] = int(w)
+	}
+	return vertices
+}
+
+// OutDegree returns the number of directed edges incident from vertex v.
+func (c *CSRDigraph) OutDegree(v int) int {
+	c.validateVertex(v)
+	return c.offsets[v+1] - c.offsets[v]
+}
+
+// Digraph returns this digraph as a Digraph with the same adjacency lists.
+func (c *CSRDigraph) Digraph() *Digraph {
+	G := NewDigraph(c.V())
+	for v := 0; v < c.V(); v++ {
+		// in reverse, as adding edges reverses the adjacency lists
+		for i := c.offsets[v+1] - 1; i >= c.offsets[v]; i-- {
+			G.AddEdge(v, int(c.heads[i]))
+		}
+	}
+	return G
+}
+
+func (c *CSRDigraph) validateVertex(v int) {
+	V := c.V()
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
@@ -0,0 +1,78 @@
+package digraph
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRDigraph(t *testing.T) {
+	assert := assert.New(t)
+	G, err := NewDigraphGeneratorSeed(47).Simple(50, 300)
+	assert.Nil(err)
+	G.AddEdge(3, 3)
+	c := NewCSRDigraph(G)
+	assert.Equal(G.V(), c.V())
+	assert.Equal(G.E(), c.E())
+	for v := 0; v < G.V(); v++ {
+		assert.Equal(G.Adj(v), c.Adj(v))
+		assert.Equal(G.OutDegree(v), c.OutDegree(v))
+	}
+	assert.Equal(G.String(), c.Digraph().String())
+
+	var b bytes.Buffer
+	assert.Nil(c.WriteBinary(&b))
+	assert.Equal(4+16+8*50+4*301, b.Len())
+	d, err := ReadCSRDigraph(bytes.NewReader(b.Bytes()))
+	assert.Nil(err)
+	assert.Equal(c, d)
+
+	// truncated or corrupted files
+	_, err = ReadCSRDigraph(bytes.NewReader(b.Bytes()[:b.Len()-1]))
+	assert.Error(err)
+	corrupt := append([]byte(nil), b.Bytes()...)
+	corrupt[len(corrupt)-4] = 0xff
+	_, err = ReadCSRDigraph(bytes.NewReader(corrupt))
+	assert.Error(err)
+	_, err = ReadCSRDigraph(bytes.NewReader([]byte("CSRX")))
+	assert.Error(err)
+
+	// malformed headers fail without allocating what they claim
+	header := func(V, E uint64, offsets ...uint64) []byte {
+		h := []byte(csrMagic)
+		for _, x := range append([]uint64{V, E}, offsets...) {
+			h = append(h, byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32), byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+		}
+		return h
+	}
+	_, err = ReadCSRDigraph(bytes.NewReader(header(1, 1<<62, 1<<62)))
+	assert.EqualError(err, "too many edges 4611686018427387904 for 1 vertices")
+	_, err = ReadCSRDigraph(bytes.NewReader(header(1<<31-1, 0)))
+	assert.Error(err)
+	_, err = ReadCSRDigraph(bytes.NewReader(header(1<<31-1, 1<<40, 1<<40)))
+	assert.Error(err)
+	_, err = ReadCSRDigraph(bytes.NewReader(header(0, 1)))
+	assert.Error(err)
+
+	assert.Panics(func() { c.Adj(50) })
+}
+
+func TestNewCSRDigraphEdges(t *testing.T) {
+	assert := assert.New(t)
+	c := NewCSRDigraphEdges(4, []int{2, 0, 2, 1, 0}, []int{3, 1, 0, 2, 3})
+	assert.Equal(5, c.E())
+	assert.Equal([]int{1, 3}, c.Adj(0))
+	assert.Equal([]int{3, 0}, c.Adj(2))
+	assert.Equal(0, c.OutDegree(3))
+
+	// empty digraphs round trip
+	var b bytes.Buffer
+	empty := NewCSRDigraphEdges(0, nil, nil)
+	assert.Nil(empty.WriteBinary(&b))
+	d, err := ReadCSRDigraph(&b)
+	assert.Nil(err)
+	assert.Equal(0, d.V())
+
+	assert.Panics(func() { NewCSRDigraphEdges(2, []int{0}, []int{2}) })
+}
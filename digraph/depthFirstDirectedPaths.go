@@ -18,7 +18,7 @@ type DepthFirstDirectedPaths struct {
 }
 
 // NewDepthFirstDirectedPaths computes a directed path from s to every other vertex in digraph G.
-func NewDepthFirstDirectedPaths(G *Digraph, s int) *DepthFirstDirectedPaths {
+func NewDepthFirstDirectedPaths(G DigraphView, s int) *DepthFirstDirectedPaths {
 	dfp := &DepthFirstDirectedPaths{
 		marked: make([]bool, G.V()),
 		edgeTo: make([]int, G.V()),
@@ -28,7 +28,7 @@ func NewDepthFirstDirectedPaths(G *Digraph, s int) *DepthFirstDirectedPaths {
 	return dfp
 }
 
-func (dfp *DepthFirstDirectedPaths) dfs(G *Digraph, v int) {
+func (dfp *DepthFirstDirectedPaths) dfs(G DigraphView, v int) {
 	dfp.marked[v] = true
 	for _, w := range G.Adj(v) {
 		if !dfp.marked[w] {
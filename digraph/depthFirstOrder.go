@@ -20,7 +20,7 @@ type DepthFirstOrder struct {
 }
 
 // NewDepthFirstOrder determines a depth-first order for the digraph G.
-func NewDepthFirstOrder(G *Digraph) *DepthFirstOrder {
+func NewDepthFirstOrder(G DigraphView) *DepthFirstOrder {
 	dfo := &DepthFirstOrder{
 		marked: make([]bool, G.V()),
 		pre:    make([]int, G.V()),
@@ -35,7 +35,7 @@ func NewDepthFirstOrder(G *Digraph) *DepthFirstOrder {
 }
 
 // NewDepthFirstOrderEWD determines a depth-first order for the edge-weighted digraph G.
-func NewDepthFirstOrderEWD(G *EdgeWeightedDigraph) *DepthFirstOrder {
+func NewDepthFirstOrderEWD(G EdgeWeightedDigraphView) *DepthFirstOrder {
 	dfo := &DepthFirstOrder{
 		marked: make([]bool, G.V()),
 		pre:    make([]int, G.V()),
@@ -49,7 +49,7 @@ func NewDepthFirstOrderEWD(G *EdgeWeightedDigraph) *DepthFirstOrder {
 	return dfo
 }
 
-func (dfo *DepthFirstOrder) dfs(G *Digraph, v int) {
+func (dfo *DepthFirstOrder) dfs(G DigraphView, v int) {
 	dfo.marked[v] = true
 	dfo.pre[v] = dfo.preCounter
 	dfo.preCounter++
@@ -64,7 +64,7 @@ func (dfo *DepthFirstOrder) dfs(G *Digraph, v int) {
 	dfo.postorder = append(dfo.postorder, v)
 }
 
-func (dfo *DepthFirstOrder) dfsEWD(G *EdgeWeightedDigraph, v int) {
+func (dfo *DepthFirstOrder) dfsEWD(G EdgeWeightedDigraphView, v int) {
 	dfo.marked[v] = true
 	dfo.pre[v] = dfo.preCounter
 	dfo.preCounter++
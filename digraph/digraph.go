@@ -30,12 +30,16 @@ import (
 // iterate over all of the vertices adjacent from a given vertex.
 // It also provides methods for returning the indegree or outdegree of a vertex,
 // the number of vertices V in the digraph, the number of edges E in the digraph,
-// and the reverse digraph. Parallel edges and self-loops are permitted.
+// and the reverse digraph, and for removing edges and vertices, contracting vertices and extracting
+// induced subgraphs. Parallel edges and self-loops are permitted.
 // This implementation uses an adjacency-lists representation,
 // which is a vertex-indexed array of Bag objects. It uses O(E + V) space,
 // where E is the number of edges and V is the number of vertices. All instance methods take O(1) time.
 // (Though, iterating over the vertices returned by adj(int) takes time proportional to the
-// outdegree of the vertex.) Constructing an empty digraph with V vertices takes O(V) time;
+// outdegree of the vertex, removing an edge v->w takes time proportional to the outdegree of v,
+// and removing or contracting vertices and extracting subgraphs take O(E + V) time, however many
+// vertices are removed at once.)
+// Constructing an empty digraph with V vertices takes O(V) time;
 // constructing a digraph with E edges and V vertices takes O(E + V) time.
 type Digraph struct {
 	v        int
@@ -105,6 +109,114 @@ func (dg *Digraph) AddEdge(v, w int) {
 	dg.e++
 }
 
+// RemoveEdge removes one directed edge v->w from this digraph, and returns true if there was one.
+func (dg *Digraph) RemoveEdge(v, w int) bool {
+	dg.validateVertex(v)
+	dg.validateVertex(w)
+	if !dg.adj[v].Remove(w) {
+		return false
+	}
+	dg.indegree[w]--
+	dg.e--
+	return true
+}
+
+// RemoveVertex removes vertex v and the edges incident on it from this digraph, renumbering every vertex
+// after v down by one so the vertices remain 0 through V - 1. It takes O(E + V) time; to remove several
+// vertices, use RemoveVertices.
+func (dg *Digraph) RemoveVertex(v int) {
+	dg.RemoveVertices([]int{v})
+}
+
+// RemoveVertices removes the given vertices and the edges incident on them from this digraph in one pass,
+// renumbering the remaining vertices in order so they remain 0 through V - 1, and returns the new number
+// of every vertex, -1 for the removed ones. It takes O(E + V) time in all, so pruning sources or sinks
+// removes each round of vertices with one call rather than one call per vertex.
+func (dg *Digraph) RemoveVertices(vertices []int) []int {
+	index := removal(dg.v, vertices)
+	*dg = *dg.relabel(index, dg.v-len(vertices))
+	return index
+}
+
+// Contract contracts vertices v and w of this digraph into one: it removes the edges between them, makes the
+// other edges incident on w incident on v, and removes w as RemoveVertex does. It returns the new number of v,
+// and takes O(E + V) time.
+func (dg *Digraph) Contract(v, w int) int {
+	dg.validateVertex(v)
+	dg.validateVertex(w)
+	if v == w {
+		panic("cannot contract a vertex with itself")
+	}
+	for dg.RemoveEdge(v, w) {
+	}
+	for dg.RemoveEdge(w, v) {
+	}
+	index := removal(dg.v, []int{w})
+	index[w] = index[v]
+	*dg = *dg.relabel(index, dg.v-1)
+	return index[v]
+}
+
+// InducedSubgraph returns the subdigraph induced by the given vertices, with vertex i for vertices[i] and every
+// edge between them, and the index of every vertex of this digraph in the subdigraph, -1 if it is not in it.
+// It takes O(E + V) time.
+func (dg *Digraph) InducedSubgraph(vertices []int) (*Digraph, []int) {
+	index := subgraphIndex(dg.v, vertices)
+	return dg.relabel(index, len(vertices)), index
+}
+
+// returns the index of every vertex of V vertices after removing the given vertices, -1 for them
+func removal(V int, vertices []int) []int {
+	index := subgraphIndex(V, vertices)
+	next := 0
+	for u := range index {
+		if index[u] >= 0 {
+			index[u] = -1
+		} else {
+			index[u] = next
+			next++
+		}
+	}
+	return index
+}
+
+// returns the index of every vertex of V vertices in the subgraph of the given vertices, -1 for the others
+func subgraphIndex(V int, vertices []int) []int {
+	index := make([]int, V)
+	for v := range index {
+		index[v] = -1
+	}
+	for i, v := range vertices {
+		if v < 0 || v >= V {
+			panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+		}
+		if index[v] >= 0 {
+			panic(fmt.Sprintln("vertex ", v, " is repeated"))
+		}
+		index[v] = i
+	}
+	return index
+}
+
+// returns the digraph on V vertices with vertex index[u] for every vertex u of this digraph with index[u] >= 0,
+// and the edges between them in the same order
+func (dg *Digraph) relabel(index []int, V int) *Digraph {
+	G := NewDigraph(V)
+	for u := 0; u < dg.v; u++ {
+		if index[u] < 0 {
+			continue
+		}
+		// in reverse, as adding edges reverses the adjacency lists
+		values := dg.adj[u].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			if w := index[values[i].(int)]; w >= 0 {
+				G.AddEdge(index[u], w)
+			}
+		}
+	}
+	return G
+}
+
 // OutDegree returns the number of directed edges incident from vertex v.
 func (dg *Digraph) OutDegree(v int) int {
 	dg.validateVertex(v)
@@ -5,18 +5,47 @@ import (
 	"time"
 
 	"github.com/emirpasic/gods/sets/treeset"
+	"github.com/handane123/algorithms/internal/graphgen"
 	"github.com/pkg/errors"
 )
 
 // DigraphGenerator struct provides static methods for creating various digraphs,
 // including Erdos-Renyi random digraphs, random DAGs, random rooted trees, random rooted DAGs,
-// random tournaments, path digraphs, cycle digraphs, and the complete digraph.
+// random tournaments, path digraphs, cycle digraphs, the complete digraph, Barabasi-Albert,
+// Watts-Strogatz, R-MAT and Kronecker digraphs, configuration-model digraphs with given degrees, and grids,
+// tori, hypercubes and random geometric graphs with the coordinates of their vertices, as digraphs or as
+// edge-weighted graphs and digraphs, random edge-weighted digraphs and DAGs, with negative weights but no
+// negative cycle or with a planted negative cycle, and random flow networks.
+// Generators constructed with equal seeds produce identical digraphs when their methods are called in the same order.
+// The zero value is ready to use and, like NewDigraphGenerator, seeds itself with the current time on first use.
+// A generator is not safe for concurrent use.
 type DigraphGenerator struct {
+	random *rand.Rand // source of randomness of this generator
 }
 
-// NewDigraphGenerator constructs the DigraphGenerator struct
+// NewDigraphGenerator constructs the DigraphGenerator struct, seeded with the current time.
 func NewDigraphGenerator() *DigraphGenerator {
-	return &DigraphGenerator{}
+	return NewDigraphGeneratorSeed(time.Now().UnixNano())
+}
+
+// NewDigraphGeneratorSeed constructs the DigraphGenerator struct with the given seed; generators with equal seeds
+// produce identical digraphs when called in the same order.
+func NewDigraphGeneratorSeed(seed int64) *DigraphGenerator {
+	return NewDigraphGeneratorRand(rand.New(rand.NewSource(seed)))
+}
+
+// NewDigraphGeneratorRand constructs the DigraphGenerator struct that draws its random numbers from r,
+// leaving the global source of math/rand untouched.
+func NewDigraphGeneratorRand(r *rand.Rand) *DigraphGenerator {
+	return &DigraphGenerator{random: r}
+}
+
+// returns the source of randomness of this generator, seeding it with the current time if there is none yet
+func (generator *DigraphGenerator) rng() *rand.Rand {
+	if generator.random == nil {
+		generator.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return generator.random
 }
 
 type privateEdge struct {
@@ -55,12 +84,11 @@ func (generator *DigraphGenerator) Simple(V, E int) (*Digraph, error) {
 		return nil, errors.New("too few edges")
 	}
 
-	rand.Seed(time.Now().Unix())
 	G := NewDigraph(V)
 	set := treeset.NewWith(comparator)
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newprivateEdge(v, w)
 		if v != w && !set.Contains(e) {
 			set.Add(e)
@@ -75,12 +103,11 @@ func (generator *DigraphGenerator) SimpleP(V int, p float64) (*Digraph, error) {
 	if p < 0.0 || p > 1.0 {
 		return nil, errors.New("probability must be between 0 and 1")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewDigraph(V)
 	for v := 0; v < V; v++ {
 		for w := 0; w < V; w++ {
 			if v != w {
-				if rand.Float64() < p {
+				if generator.rng().Float64() < p {
 					G.AddEdge(v, w)
 				}
 			}
@@ -112,11 +139,11 @@ func (generator *DigraphGenerator) Dag(V, E int) (*Digraph, error) {
 	}
 	G := NewDigraph(V)
 	set := treeset.NewWith(comparator)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newprivateEdge(v, w)
 		if v < w && !set.Contains(e) {
 			set.Add(e)
@@ -129,10 +156,9 @@ func (generator *DigraphGenerator) Dag(V, E int) (*Digraph, error) {
 // Tournament returns a random tournament digraph on V vertices.
 func (generator *DigraphGenerator) Tournament(V int) *Digraph {
 	G := NewDigraph(V)
-	rand.Seed(time.Now().Unix())
 	for v := 0; v < G.V(); v++ {
 		for w := v + 1; w < G.V(); w++ {
-			if rand.Float64() < 0.5 {
+			if generator.rng().Float64() < 0.5 {
 				G.AddEdge(v, w)
 			} else {
 				G.AddEdge(w, v)
@@ -145,7 +171,7 @@ func (generator *DigraphGenerator) Tournament(V int) *Digraph {
 // CompleteRootedInDAG returns a complete rooted-in DAG on V vertices.
 func (generator *DigraphGenerator) CompleteRootedInDAG(V int) *Digraph {
 	G := NewDigraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V; i++ {
 		for j := i + 1; j < V; j++ {
 			G.AddEdge(vertices[i], vertices[j])
@@ -164,17 +190,16 @@ func (generator *DigraphGenerator) RootedInDAG(V, E int) (*Digraph, error) {
 	}
 	G := NewDigraph(V)
 	set := treeset.NewWith(comparator)
-	vertices := createVertices(V)
-	rand.Seed(time.Now().Unix())
+	vertices := generator.createVertices(V)
 	for v := 0; v < V-1; v++ {
-		w := rand.Intn(V-v-1) + v + 1
+		w := generator.rng().Intn(V-v-1) + v + 1
 		e := newprivateEdge(v, w)
 		set.Add(e)
 		G.AddEdge(vertices[v], vertices[w])
 	}
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newprivateEdge(v, w)
 		if v < w && !set.Contains(e) {
 			set.Add(e)
@@ -187,7 +212,7 @@ func (generator *DigraphGenerator) RootedInDAG(V, E int) (*Digraph, error) {
 // CompleteRootedOutDAG returns a complete rooted-out DAG on V vertices.
 func (generator *DigraphGenerator) CompleteRootedOutDAG(V int) *Digraph {
 	G := NewDigraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V; i++ {
 		for j := i + 1; j < V; j++ {
 			G.AddEdge(vertices[j], vertices[i])
@@ -207,19 +232,18 @@ func (generator *DigraphGenerator) RootedOutDAG(V, E int) (*Digraph, error) {
 	G := NewDigraph(V)
 	set := treeset.NewWith(comparator)
 	// fix a topological order
-	vertices := createVertices(V)
-	rand.Seed(time.Now().Unix())
+	vertices := generator.createVertices(V)
 
 	// one edge pointing from each vertex, other than the root = vertices[V-1]
 	for v := 0; v < V-1; v++ {
-		w := rand.Intn(V-v-1) + v + 1
+		w := generator.rng().Intn(V-v-1) + v + 1
 		e := newprivateEdge(w, v)
 		set.Add(e)
 		G.AddEdge(vertices[w], vertices[v])
 	}
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newprivateEdge(w, v)
 		if v < w && !set.Contains(e) {
 			set.Add(e)
@@ -242,7 +266,7 @@ func (generator *DigraphGenerator) RootedOutTree(V int) (*Digraph, error) {
 // PathDigraph returns a path digraph on V vertices.
 func (generator *DigraphGenerator) PathDigraph(V int) *Digraph {
 	G := NewDigraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
 	}
@@ -252,7 +276,7 @@ func (generator *DigraphGenerator) PathDigraph(V int) *Digraph {
 // BinaryTree returns a complete binary tree digraph on V vertices.
 func (generator *DigraphGenerator) BinaryTree(V int) *Digraph {
 	G := NewDigraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 1; i < V; i++ {
 		G.AddEdge(vertices[i], vertices[(i-1)/2])
 	}
@@ -262,7 +286,7 @@ func (generator *DigraphGenerator) BinaryTree(V int) *Digraph {
 // CycleDigraph returns a cycle digraph on V vertices.
 func (generator *DigraphGenerator) CycleDigraph(V int) *Digraph {
 	G := NewDigraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
 	}
@@ -279,10 +303,9 @@ func (generator *DigraphGenerator) EulerianCycleDigraph(V, E int) (*Digraph, err
 		return nil, errors.New("an Eulerian cycle must at least one vertex")
 	}
 	G := NewDigraph(V)
-	rand.Seed(time.Now().Unix())
 	vertices := make([]int, E)
 	for i := range vertices {
-		vertices[i] = rand.Intn(V)
+		vertices[i] = generator.rng().Intn(V)
 	}
 	for i := 0; i < E-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
@@ -300,10 +323,9 @@ func (generator *DigraphGenerator) EulerianPathDigraph(V, E int) (*Digraph, erro
 		return nil, errors.New("an Eulerian path must have at least one vertex")
 	}
 	G := NewDigraph(V)
-	rand.Seed(time.Now().Unix())
 	vertices := make([]int, E+1)
 	for i := range vertices {
-		vertices[i] = rand.Intn(V)
+		vertices[i] = generator.rng().Intn(V)
 	}
 	for i := 0; i < E; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
@@ -322,14 +344,13 @@ func (generator *DigraphGenerator) StrongDigraph(V, E, c int) (*Digraph, error)
 	if E > V*(V-1)/2 {
 		return nil, errors.New("too many edges")
 	}
-	rand.Seed(time.Now().Unix())
 	// the digraph
 	G := NewDigraph(V)
 	// edges added to G (to avoid duplicate edges)
 	set := treeset.NewWith(comparator)
 	label := make([]int, V)
 	for i := range label {
-		label[i] = rand.Intn(c)
+		label[i] = generator.rng().Intn(c)
 	}
 
 	// make all vertices with label c a strong component by
@@ -350,13 +371,13 @@ func (generator *DigraphGenerator) StrongDigraph(V, E, c int) (*Digraph, error)
 				j++
 			}
 		}
-		rand.Shuffle(len(vertices), func(i, j int) {
+		generator.rng().Shuffle(len(vertices), func(i, j int) {
 			vertices[i], vertices[j] = vertices[j], vertices[i]
 		})
 
 		// rooted-in tree with root = vertices[count-1]
 		for v := 0; v < count-1; v++ {
-			w := rand.Intn(count-v-1) + v + 1
+			w := generator.rng().Intn(count-v-1) + v + 1
 			e := newprivateEdge(w, v)
 			set.Add(e)
 			G.AddEdge(vertices[w], vertices[v])
@@ -364,7 +385,7 @@ func (generator *DigraphGenerator) StrongDigraph(V, E, c int) (*Digraph, error)
 
 		// rooted-out tree with root = vertices[count-1]
 		for v := 0; v < count-1; v++ {
-			w := rand.Intn(count-v-1) + v + 1
+			w := generator.rng().Intn(count-v-1) + v + 1
 			e := newprivateEdge(v, w)
 			set.Add(e)
 			G.AddEdge(vertices[v], vertices[w])
@@ -372,8 +393,8 @@ func (generator *DigraphGenerator) StrongDigraph(V, E, c int) (*Digraph, error)
 	}
 
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newprivateEdge(v, w)
 		if !set.Contains(e) && v != w && label[v] <= label[w] {
 			set.Add(e)
@@ -383,13 +404,170 @@ func (generator *DigraphGenerator) StrongDigraph(V, E, c int) (*Digraph, error)
 	return G, nil
 }
 
-func createVertices(capacity int) []int {
-	rand.Seed(time.Now().Unix())
+// BarabasiAlbert returns a random scale-free digraph on V vertices grown by preferential attachment (Price's
+// model): starting from m vertices without edges, every new vertex points to m distinct earlier vertices, each
+// chosen with probability proportional to its indegree plus one.
+func (generator *DigraphGenerator) BarabasiAlbert(V, m int) (*Digraph, error) {
+	if m < 1 || m >= V {
+		return nil, errors.New("m must be between 1 and V-1")
+	}
+	G := NewDigraph(V)
+	// every vertex appears once, plus once for each edge pointing to it
+	var heads []int
+	for v := 0; v < m; v++ {
+		heads = append(heads, v)
+	}
+	for v := m; v < V; v++ {
+		targets := make([]int, 0, m)
+		chosen := make(map[int]bool)
+		for len(targets) < m {
+			w := heads[generator.rng().Intn(len(heads))]
+			if !chosen[w] {
+				chosen[w] = true
+				targets = append(targets, w)
+			}
+		}
+		for _, w := range targets {
+			G.AddEdge(v, w)
+			heads = append(heads, w)
+		}
+		heads = append(heads, v)
+	}
+	return G, nil
+}
+
+// WattsStrogatz returns a random small-world digraph on V vertices: a ring in which every vertex points to the
+// next k vertices clockwise, whose edges are rewired with probability p by replacing the head with a uniformly
+// random vertex, avoiding self-loops and parallel edges.
+func (generator *DigraphGenerator) WattsStrogatz(V, k int, p float64) (*Digraph, error) {
+	if k < 0 || k >= V {
+		return nil, errors.New("k must be between 0 and V-1")
+	}
+	if p < 0.0 || p > 1.0 {
+		return nil, errors.New("probability must be between 0 and 1")
+	}
+	var edges []*privateEdge
+	set := treeset.NewWith(comparator)
+	for j := 1; j <= k; j++ {
+		for v := 0; v < V; v++ {
+			e := newprivateEdge(v, (v+j)%V)
+			edges = append(edges, e)
+			set.Add(e)
+		}
+	}
+	for i, e := range edges {
+		// every vertex has outdegree k, so it can be rewired unless it points to every other vertex
+		if k == V-1 || generator.rng().Float64() >= p {
+			continue
+		}
+		w := generator.rng().Intn(V)
+		for w == e.v || set.Contains(newprivateEdge(e.v, w)) {
+			w = generator.rng().Intn(V)
+		}
+		set.Remove(e)
+		edges[i] = newprivateEdge(e.v, w)
+		set.Add(edges[i])
+	}
+	G := NewDigraph(V)
+	for _, e := range edges {
+		G.AddEdge(e.v, e.w)
+	}
+	return G, nil
+}
+
+// RMAT returns a random simple digraph on 2^scale vertices and E edges generated by the R-MAT model: every edge
+// is placed by descending scale levels of the adjacency matrix, choosing the top-left, top-right, bottom-left or
+// bottom-right quadrant with probabilities a, b, c and 1-a-b-c; self-loops and repeated edges are discarded.
+func (generator *DigraphGenerator) RMAT(scale, E int, a, b, c float64) (*Digraph, error) {
+	if err := graphgen.ValidateRMAT(scale, a, b, c); err != nil {
+		return nil, err
+	}
+	V := 1 << scale
+	if E > V*(V-1) {
+		return nil, errors.New("too many edges")
+	}
+	if E < 0 {
+		return nil, errors.New("too few edges")
+	}
+	// the probabilities may confine the edges to too few entries to ever draw E of them
+	if E > graphgen.RMATEdges(scale, a, b, c, true) {
+		return nil, errors.New("too many edges for the probabilities")
+	}
+	G := NewDigraph(V)
+	set := treeset.NewWith(comparator)
+	for G.E() < E {
+		v, w := graphgen.RMATEdge(generator.rng(), scale, a, b, c)
+		e := newprivateEdge(v, w)
+		if v != w && !set.Contains(e) {
+			set.Add(e)
+			G.AddEdge(v, w)
+		}
+	}
+	return G, nil
+}
+
+// Kronecker returns a random digraph drawn from the stochastic Kronecker graph given by the k-th Kronecker power
+// of an n-by-n initiator matrix of probabilities: it has n^k vertices, and there is an edge from v to a distinct
+// vertex w, both written as k digits in base n, with probability equal to the product of the initiator entries
+// indexed by the digits of v and w. It takes time proportional to n^2k.
+func (generator *DigraphGenerator) Kronecker(initiator [][]float64, k int) (*Digraph, error) {
+	if err := graphgen.ValidateInitiator(initiator, k); err != nil {
+		return nil, err
+	}
+	n := len(initiator)
+	V := 1
+	for i := 0; i < k; i++ {
+		V *= n
+	}
+	G := NewDigraph(V)
+	for v := 0; v < V; v++ {
+		for w := 0; w < V; w++ {
+			if v != w && generator.rng().Float64() < graphgen.KroneckerProbability(initiator, k, v, w) {
+				G.AddEdge(v, w)
+			}
+		}
+	}
+	return G, nil
+}
+
+// ConfigurationModel returns a random digraph (not necessarily simple) in which vertex v has outdegree
+// outdegrees[v] and indegree indegrees[v], built by matching the tails of the edges to their heads
+// uniformly at random.
+func (generator *DigraphGenerator) ConfigurationModel(outdegrees, indegrees []int) (*Digraph, error) {
+	if len(outdegrees) != len(indegrees) {
+		return nil, errors.New("outdegrees and indegrees must have the same length")
+	}
+	var tails, heads []int
+	for v := range outdegrees {
+		if outdegrees[v] < 0 || indegrees[v] < 0 {
+			return nil, errors.New("degrees must be non negative")
+		}
+		for i := 0; i < outdegrees[v]; i++ {
+			tails = append(tails, v)
+		}
+		for i := 0; i < indegrees[v]; i++ {
+			heads = append(heads, v)
+		}
+	}
+	if len(tails) != len(heads) {
+		return nil, errors.New("sums of outdegrees and indegrees must be equal")
+	}
+	generator.rng().Shuffle(len(heads), func(i, j int) {
+		heads[i], heads[j] = heads[j], heads[i]
+	})
+	G := NewDigraph(len(outdegrees))
+	for i := range tails {
+		G.AddEdge(tails[i], heads[i])
+	}
+	return G, nil
+}
+
+func (generator *DigraphGenerator) createVertices(capacity int) []int {
 	vertices := make([]int, capacity)
 	for i := 0; i < capacity; i++ {
 		vertices[i] = i
 	}
-	rand.Shuffle(capacity, func(i, j int) {
+	generator.rng().Shuffle(capacity, func(i, j int) {
 		vertices[i], vertices[j] = vertices[j], vertices[i]
 	})
 	return vertices
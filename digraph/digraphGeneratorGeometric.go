@@ -0,0 +1,176 @@
+package digraph
+
+import (
+	"github.com/handane123/algorithms/internal/graphgen"
+)
+
+// EdgeWeights selects the weights of the edges of the edge-weighted geometric generators.
+type EdgeWeights int
+
+const (
+	// EuclideanWeights weighs every edge by the distance between the coordinates of its endpoints
+	// (around the torus for torus graphs).
+	EuclideanWeights EdgeWeights = iota
+	// RandomWeights weighs every edge by its Euclidean weight times a uniformly random factor between 1 and 2.
+	// On grids, hypercubes and random geometric graphs, but not on tori, either weights keep the distance between
+	// the coordinates of two vertices a lower bound on the weight of a path between them, as A* search requires:
+	// an edge wrapping around a torus is short while its endpoints are far apart in coordinates.
+	RandomWeights
+)
+
+// returns the weight of the edge v-w of the lattice
+func (generator *DigraphGenerator) weight(l *graphgen.Lattice, v, w int, weights EdgeWeights) float64 {
+	if weights == RandomWeights {
+		return l.Length(v, w) * (1 + generator.rng().Float64())
+	}
+	return l.Length(v, w)
+}
+
+// returns the digraph with an edge in each direction for every edge of the lattice
+func latticeDigraph(l *graphgen.Lattice) *Digraph {
+	G := NewDigraph(len(l.Coords))
+	for _, e := range l.Edges {
+		G.AddEdge(e[0], e[1])
+		G.AddEdge(e[1], e[0])
+	}
+	return G
+}
+
+func (generator *DigraphGenerator) edgeWeightedGraph(l *graphgen.Lattice, weights EdgeWeights) *EdgeWeightedGraph {
+	G := NewEdgeWeightedGraphV(len(l.Coords))
+	for _, e := range l.Edges {
+		G.AddEdge(NewEdge(e[0], e[1], generator.weight(l, e[0], e[1], weights)))
+	}
+	return G
+}
+
+// returns the edge-weighted digraph with an edge in each direction for every edge of the lattice,
+// the two weighed independently
+func (generator *DigraphGenerator) edgeWeightedDigraph(l *graphgen.Lattice, weights EdgeWeights) *EdgeWeightedDigraph {
+	G := NewEdgeWeightedDigraphV(len(l.Coords))
+	for _, e := range l.Edges {
+		G.AddEdge(NewDirectedEdge(e[0], e[1], generator.weight(l, e[0], e[1], weights)))
+		G.AddEdge(NewDirectedEdge(e[1], e[0], generator.weight(l, e[1], e[0], weights)))
+	}
+	return G
+}
+
+// Grid returns the grid digraph with sizes[i] vertices along dimension i (two dimensions for a 2D grid, three
+// for a 3D grid), with an edge in each direction between neighbors, and the coordinates of every vertex.
+// Vertex v has coordinates given by the digits of v in the mixed radix of the sizes, the first fastest,
+// so in a 2D grid vertex x + sizes[0] y is at (x, y).
+func (generator *DigraphGenerator) Grid(sizes []int) (*Digraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeDigraph(l), l.Coords, nil
+}
+
+// Torus returns the grid digraph with sizes[i] vertices along dimension i, at least 3, wrapped around along
+// every dimension, and the coordinates of every vertex as for Grid.
+func (generator *DigraphGenerator) Torus(sizes []int) (*Digraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeDigraph(l), l.Coords, nil
+}
+
+// Hypercube returns the hypercube digraph of the given dimension, on the 2^dimension vertices whose binary digits
+// are their coordinates, with an edge in each direction between vertices that differ in one digit.
+func (generator *DigraphGenerator) Hypercube(dimension int) (*Digraph, [][]float64, error) {
+	l, err := graphgen.NewHypercube(dimension)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeDigraph(l), l.Coords, nil
+}
+
+// RandomGeometric returns a random geometric digraph on V uniformly random points of the unit cube of the given
+// dimension, with an edge in each direction between points at distance at most radius, and the coordinates
+// of every vertex.
+func (generator *DigraphGenerator) RandomGeometric(V, dimension int, radius float64) (*Digraph, [][]float64, error) {
+	l, err := graphgen.NewGeometricLattice(generator.rng(), V, dimension, radius)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeDigraph(l), l.Coords, nil
+}
+
+// WeightedGrid returns the grid of Grid as an edge-weighted graph with the given weights.
+func (generator *DigraphGenerator) WeightedGrid(sizes []int, weights EdgeWeights) (*EdgeWeightedGraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedGraph(l, weights), l.Coords, nil
+}
+
+// WeightedTorus returns the torus of Torus as an edge-weighted graph with the given weights.
+func (generator *DigraphGenerator) WeightedTorus(sizes []int, weights EdgeWeights) (*EdgeWeightedGraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedGraph(l, weights), l.Coords, nil
+}
+
+// WeightedHypercube returns the hypercube of Hypercube as an edge-weighted graph with the given weights.
+func (generator *DigraphGenerator) WeightedHypercube(dimension int, weights EdgeWeights) (*EdgeWeightedGraph, [][]float64, error) {
+	l, err := graphgen.NewHypercube(dimension)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedGraph(l, weights), l.Coords, nil
+}
+
+// WeightedRandomGeometric returns a random geometric graph as in RandomGeometric as an edge-weighted graph
+// with the given weights.
+func (generator *DigraphGenerator) WeightedRandomGeometric(V, dimension int, radius float64, weights EdgeWeights) (*EdgeWeightedGraph, [][]float64, error) {
+	l, err := graphgen.NewGeometricLattice(generator.rng(), V, dimension, radius)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedGraph(l, weights), l.Coords, nil
+}
+
+// WeightedGridDigraph returns the grid of Grid as an edge-weighted digraph with the given weights,
+// drawn independently for the two directions of an edge.
+func (generator *DigraphGenerator) WeightedGridDigraph(sizes []int, weights EdgeWeights) (*EdgeWeightedDigraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedDigraph(l, weights), l.Coords, nil
+}
+
+// WeightedTorusDigraph returns the torus of Torus as an edge-weighted digraph with the given weights,
+// drawn independently for the two directions of an edge.
+func (generator *DigraphGenerator) WeightedTorusDigraph(sizes []int, weights EdgeWeights) (*EdgeWeightedDigraph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedDigraph(l, weights), l.Coords, nil
+}
+
+// WeightedHypercubeDigraph returns the hypercube of Hypercube as an edge-weighted digraph with the given weights,
+// drawn independently for the two directions of an edge.
+func (generator *DigraphGenerator) WeightedHypercubeDigraph(dimension int, weights EdgeWeights) (*EdgeWeightedDigraph, [][]float64, error) {
+	l, err := graphgen.NewHypercube(dimension)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedDigraph(l, weights), l.Coords, nil
+}
+
+// WeightedRandomGeometricDigraph returns a random geometric graph as in RandomGeometric as an edge-weighted
+// digraph with the given weights, drawn independently for the two directions of an edge.
+func (generator *DigraphGenerator) WeightedRandomGeometricDigraph(V, dimension int, radius float64, weights EdgeWeights) (*EdgeWeightedDigraph, [][]float64, error) {
+	l, err := graphgen.NewGeometricLattice(generator.rng(), V, dimension, radius)
+	if err != nil {
+		return nil, nil, err
+	}
+	return generator.edgeWeightedDigraph(l, weights), l.Coords, nil
+}
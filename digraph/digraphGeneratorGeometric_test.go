@@ -0,0 +1,89 @@
+package digraph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigraphGenerator_Grid(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(43)
+	assert := assert.New(t)
+
+	G, coords, err := generator.Grid([]int{4, 3})
+	assert.Nil(err)
+	assert.Equal(2*(3*3+4*2), G.E())
+	assert.Equal([]float64{1, 2}, coords[9])
+	assert.ElementsMatch([]int{8, 10, 5}, G.Adj(9))
+
+	H, _, err := generator.Hypercube(3)
+	assert.Nil(err)
+	assert.Equal(24, H.E())
+	T, _, err := generator.Torus([]int{3, 3, 3})
+	assert.Nil(err)
+	assert.Equal(162, T.E())
+	_, _, err = generator.Torus([]int{1})
+	assert.Error(err)
+
+	// Euclidean weights on a torus measure around it
+	W, coords, err := generator.WeightedTorus([]int{4, 5}, EuclideanWeights)
+	assert.Nil(err)
+	assert.Equal(40, W.E())
+	for _, e := range W.Edges() {
+		assert.Equal(1.0, e.Weight())
+	}
+	assert.Equal([]float64{3, 4}, coords[19])
+
+	// random weights are at least the Euclidean ones, so A* with straight-line distances finds shortest paths
+	D, coords, err := generator.WeightedGridDigraph([]int{6, 6}, RandomWeights)
+	assert.Nil(err)
+	assert.Equal(120, D.E())
+	for _, e := range D.Edges() {
+		assert.True(e.Weight() >= 1 && e.Weight() < 2)
+	}
+	sp := NewDijkstraSP(D, 0)
+	assert.True(sp.DistTo(35) >= math.Hypot(coords[35][0], coords[35][1]))
+
+	C, _, err := generator.WeightedHypercubeDigraph(3, EuclideanWeights)
+	assert.Nil(err)
+	assert.Equal(3.0, NewDijkstraSP(C, 0).DistTo(7))
+	_, _, err = generator.WeightedHypercube(31, EuclideanWeights)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_RandomGeometric(t *testing.T) {
+	assert := assert.New(t)
+	radius := 0.3
+	G, coords, err := NewDigraphGeneratorSeed(43).WeightedRandomGeometric(200, 3, radius, EuclideanWeights)
+	assert.Nil(err)
+	distance := func(v, w int) float64 {
+		sum := 0.0
+		for i := range coords[v] {
+			sum += (coords[v][i] - coords[w][i]) * (coords[v][i] - coords[w][i])
+		}
+		return math.Sqrt(sum)
+	}
+	count := 0
+	for v := 0; v < G.V(); v++ {
+		for w := v + 1; w < G.V(); w++ {
+			if distance(v, w) <= radius {
+				count++
+			}
+		}
+	}
+	assert.Equal(count, G.E())
+	for _, e := range G.Edges() {
+		v := e.Either()
+		assert.InDelta(distance(v, e.Other(v)), e.Weight(), 1e-12)
+	}
+
+	// equal seeds give equal graphs and coordinates
+	D1, coords1, _ := NewDigraphGeneratorSeed(5).WeightedRandomGeometricDigraph(50, 2, 0.2, RandomWeights)
+	D2, coords2, _ := NewDigraphGeneratorSeed(5).WeightedRandomGeometricDigraph(50, 2, 0.2, RandomWeights)
+	assert.Equal(coords1, coords2)
+	assert.Equal(D1.String(), D2.String())
+	U, _, err := NewDigraphGeneratorSeed(5).RandomGeometric(50, 2, 0.2)
+	assert.Nil(err)
+	assert.Equal(D1.E(), U.E())
+}
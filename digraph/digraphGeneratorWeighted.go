@@ -0,0 +1,175 @@
+package digraph
+
+import (
+	"github.com/emirpasic/gods/sets/treeset"
+	"github.com/pkg/errors"
+)
+
+// returns E distinct random edges v->w between distinct vertices among V, avoiding those in the set
+// and those for which allowed returns false, and adds them to the set
+func (generator *DigraphGenerator) randomEdges(V, E int, set *treeset.Set, allowed func(v, w int) bool) (edges []*privateEdge) {
+	for len(edges) < E {
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
+		e := newprivateEdge(v, w)
+		if v != w && allowed(v, w) && !set.Contains(e) {
+			set.Add(e)
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+func validateEdgeCount(E, max int) error {
+	if E > max {
+		return errors.New("too many edges")
+	}
+	if E < 0 {
+		return errors.New("too few edges")
+	}
+	return nil
+}
+
+// EdgeWeightedSimple returns a random simple edge-weighted digraph containing V vertices and E edges,
+// with weights uniformly distributed between minWeight and maxWeight.
+func (generator *DigraphGenerator) EdgeWeightedSimple(V, E int, minWeight, maxWeight float64) (*EdgeWeightedDigraph, error) {
+	if err := validateEdgeCount(E, V*(V-1)); err != nil {
+		return nil, err
+	}
+	if minWeight > maxWeight {
+		return nil, errors.New("minimum weight must not exceed maximum weight")
+	}
+	G := NewEdgeWeightedDigraphV(V)
+	all := func(v, w int) bool { return true }
+	for _, e := range generator.randomEdges(V, E, treeset.NewWith(comparator), all) {
+		weight := minWeight + (maxWeight-minWeight)*generator.rng().Float64()
+		G.AddEdge(NewDirectedEdge(e.v, e.w, weight))
+	}
+	return G, nil
+}
+
+// EdgeWeightedDAG returns a random simple edge-weighted DAG containing V vertices and E edges,
+// with weights uniformly distributed between minWeight and maxWeight, which may be negative.
+func (generator *DigraphGenerator) EdgeWeightedDAG(V, E int, minWeight, maxWeight float64) (*EdgeWeightedDigraph, error) {
+	if err := validateEdgeCount(E, V*(V-1)/2); err != nil {
+		return nil, err
+	}
+	if minWeight > maxWeight {
+		return nil, errors.New("minimum weight must not exceed maximum weight")
+	}
+	G := NewEdgeWeightedDigraphV(V)
+	// edges go forward in a random topological order
+	vertices := generator.createVertices(V)
+	forward := func(v, w int) bool { return v < w }
+	for _, e := range generator.randomEdges(V, E, treeset.NewWith(comparator), forward) {
+		weight := minWeight + (maxWeight-minWeight)*generator.rng().Float64()
+		G.AddEdge(NewDirectedEdge(vertices[e.v], vertices[e.w], weight))
+	}
+	return G, nil
+}
+
+// returns the weight of an edge v->w shifted by the difference of the potentials of its endpoints,
+// which adds up to zero around every cycle
+func shifted(weight float64, potential []float64, v, w int) float64 {
+	return weight + potential[v] - potential[w]
+}
+
+func (generator *DigraphGenerator) potentials(V int, maxPotential float64) []float64 {
+	potential := make([]float64, V)
+	for v := range potential {
+		potential[v] = maxPotential * generator.rng().Float64()
+	}
+	return potential
+}
+
+// EdgeWeightedNoNegativeCycle returns a random simple edge-weighted digraph containing V vertices and E edges,
+// with negative weights but no negative cycle: every edge v->w has a weight uniformly distributed between 0 and
+// maxWeight, plus p(v) - p(w) for random potentials p between 0 and maxPotential, so every cycle weighs as
+// much as its unshifted weights.
+func (generator *DigraphGenerator) EdgeWeightedNoNegativeCycle(V, E int, maxWeight, maxPotential float64) (*EdgeWeightedDigraph, error) {
+	if err := validateEdgeCount(E, V*(V-1)); err != nil {
+		return nil, err
+	}
+	if maxWeight < 0 || maxPotential < 0 {
+		return nil, errors.New("maximum weight and potential must be non negative")
+	}
+	G := NewEdgeWeightedDigraphV(V)
+	potential := generator.potentials(V, maxPotential)
+	all := func(v, w int) bool { return true }
+	for _, e := range generator.randomEdges(V, E, treeset.NewWith(comparator), all) {
+		weight := shifted(maxWeight*generator.rng().Float64(), potential, e.v, e.w)
+		G.AddEdge(NewDirectedEdge(e.v, e.w, weight))
+	}
+	return G, nil
+}
+
+// EdgeWeightedNegativeCycle returns a random simple edge-weighted digraph containing V vertices and E edges,
+// built as in EdgeWeightedNoNegativeCycle except for a planted cycle through k random vertices, whose edges have
+// an unshifted weight between -maxWeight and 0, and the edges of that cycle, which is negative.
+// Other negative cycles can use edges of the planted one.
+func (generator *DigraphGenerator) EdgeWeightedNegativeCycle(V, E, k int, maxWeight, maxPotential float64) (*EdgeWeightedDigraph, []*DirectedEdge, error) {
+	if err := validateEdgeCount(E, V*(V-1)); err != nil {
+		return nil, nil, err
+	}
+	if k < 2 || k > V || k > E {
+		return nil, nil, errors.New("cycle length must be between 2 and the number of vertices and edges")
+	}
+	if maxWeight <= 0 || maxPotential < 0 {
+		return nil, nil, errors.New("maximum weight must be positive and potential non negative")
+	}
+	G := NewEdgeWeightedDigraphV(V)
+	potential := generator.potentials(V, maxPotential)
+	set := treeset.NewWith(comparator)
+	vertices := generator.createVertices(V)[:k]
+	var cycle []*DirectedEdge
+	for i, v := range vertices {
+		w := vertices[(i+1)%k]
+		set.Add(newprivateEdge(v, w))
+		// 1 - Float64() is never 0, so the cycle is strictly negative
+		weight := shifted(-maxWeight*(1-generator.rng().Float64()), potential, v, w)
+		cycle = append(cycle, NewDirectedEdge(v, w, weight))
+		G.AddEdge(cycle[i])
+	}
+	all := func(v, w int) bool { return true }
+	for _, e := range generator.randomEdges(V, E-k, set, all) {
+		weight := shifted(maxWeight*generator.rng().Float64(), potential, e.v, e.w)
+		G.AddEdge(NewDirectedEdge(e.v, e.w, weight))
+	}
+	return G, cycle, nil
+}
+
+// FlowNetwork returns a random simple flow network containing V vertices and E edges,
+// with capacities uniformly distributed between minCapacity and maxCapacity.
+func (generator *DigraphGenerator) FlowNetwork(V, E int, minCapacity, maxCapacity float64) (*FlowNetwork, error) {
+	if err := validateEdgeCount(E, V*(V-1)); err != nil {
+		return nil, err
+	}
+	if minCapacity < 0 || minCapacity > maxCapacity {
+		return nil, errors.New("capacities must be non negative and the minimum must not exceed the maximum")
+	}
+	G := NewFlowNetwork(V)
+	all := func(v, w int) bool { return true }
+	for _, e := range generator.randomEdges(V, E, treeset.NewWith(comparator), all) {
+		capacity := minCapacity + (maxCapacity-minCapacity)*generator.rng().Float64()
+		G.AddEdge(NewFlowEdge(e.v, e.w, capacity))
+	}
+	return G, nil
+}
+
+// IntegralFlowNetwork returns a random simple flow network containing V vertices and E edges,
+// with integer capacities uniformly distributed between minCapacity and maxCapacity, inclusive.
+func (generator *DigraphGenerator) IntegralFlowNetwork(V, E int, minCapacity, maxCapacity int) (*FlowNetwork, error) {
+	if err := validateEdgeCount(E, V*(V-1)); err != nil {
+		return nil, err
+	}
+	if minCapacity < 0 || minCapacity > maxCapacity {
+		return nil, errors.New("capacities must be non negative and the minimum must not exceed the maximum")
+	}
+	G := NewFlowNetwork(V)
+	all := func(v, w int) bool { return true }
+	for _, e := range generator.randomEdges(V, E, treeset.NewWith(comparator), all) {
+		capacity := minCapacity + generator.rng().Intn(maxCapacity-minCapacity+1)
+		G.AddEdge(NewFlowEdge(e.v, e.w, float64(capacity)))
+	}
+	return G, nil
+}
@@ -0,0 +1,92 @@
+package digraph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigraphGenerator_EdgeWeightedDAG(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(44)
+	assert := assert.New(t)
+
+	G, err := generator.EdgeWeightedDAG(20, 100, -5, 5)
+	assert.Nil(err)
+	assert.Equal(100, G.E())
+	assert.False(NewEdgeWeightedDirectedCycle(G).HasCycle())
+	for _, e := range G.Edges() {
+		assert.True(e.Weight() >= -5 && e.Weight() < 5)
+	}
+	_, err = generator.EdgeWeightedDAG(5, 11, 0, 1)
+	assert.Error(err)
+
+	S, err := generator.EdgeWeightedSimple(6, 30, 1, 2)
+	assert.Nil(err)
+	assert.Equal(30, S.E())
+	_, err = generator.EdgeWeightedSimple(6, 10, 2, 1)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_EdgeWeightedNegativeCycle(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(44)
+	assert := assert.New(t)
+
+	G, err := generator.EdgeWeightedNoNegativeCycle(20, 120, 10, 50)
+	assert.Nil(err)
+	assert.Equal(120, G.E())
+	negative := 0
+	for _, e := range G.Edges() {
+		if e.Weight() < 0 {
+			negative++
+		}
+	}
+	assert.True(negative > 0)
+	for s := 0; s < G.V(); s++ {
+		assert.False(NewBellmanFordSP(G, s).HasNegativeCycle())
+	}
+
+	G, cycle, err := generator.EdgeWeightedNegativeCycle(20, 120, 5, 10, 50)
+	assert.Nil(err)
+	assert.Equal(120, G.E())
+	assert.Len(cycle, 5)
+	weight := 0.0
+	for i, e := range cycle {
+		assert.Equal(cycle[(i+1)%len(cycle)].From(), e.To())
+		weight += e.Weight()
+	}
+	assert.True(weight < 0)
+	assert.True(NewBellmanFordSP(G, cycle[0].From()).HasNegativeCycle())
+
+	_, _, err = generator.EdgeWeightedNegativeCycle(20, 120, 1, 10, 50)
+	assert.Error(err)
+	_, _, err = generator.EdgeWeightedNegativeCycle(20, 120, 5, 0, 50)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_FlowNetwork(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(44)
+	assert := assert.New(t)
+
+	G, err := generator.FlowNetwork(10, 40, 1, 3)
+	assert.Nil(err)
+	assert.Equal(40, G.E())
+	for _, e := range G.Edges() {
+		assert.True(e.Capacity() >= 1 && e.Capacity() < 3)
+		assert.NotEqual(e.From(), e.To())
+	}
+
+	I, err := generator.IntegralFlowNetwork(10, 40, 0, 4)
+	assert.Nil(err)
+	for _, e := range I.Edges() {
+		assert.Equal(math.Trunc(e.Capacity()), e.Capacity())
+		assert.True(e.Capacity() >= 0 && e.Capacity() <= 4)
+	}
+	_, err = generator.IntegralFlowNetwork(10, 40, -1, 4)
+	assert.Error(err)
+
+	// equal seeds give equal networks
+	A, _ := NewDigraphGeneratorSeed(7).IntegralFlowNetwork(8, 20, 1, 9)
+	B, _ := NewDigraphGeneratorSeed(7).IntegralFlowNetwork(8, 20, 1, 9)
+	assert.Equal(A.String(), B.String())
+}
@@ -1,6 +1,9 @@
 package digraph
 
 import (
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +18,18 @@ func TestDigraphGenerator_Complete(t *testing.T) {
 	assert.Equal(42, G.E())
 }
 
+func TestDigraphGenerator_ZeroValue(t *testing.T) {
+	assert := assert.New(t)
+	var generator DigraphGenerator
+	G, err := generator.Dag(10, 20)
+	assert.Nil(err)
+	assert.Equal(20, G.E())
+	assert.NotNil(generator.random)
+	fn, err := (&DigraphGenerator{}).FlowNetwork(10, 20, 1, 5)
+	assert.Nil(err)
+	assert.Equal(20, fn.E())
+}
+
 func TestDigraphGenerator_DAG(t *testing.T) {
 	generator := NewDigraphGenerator()
 	assert := assert.New(t)
@@ -146,3 +161,151 @@ func TestDigraphGenerator_StrongDigraph(t *testing.T) {
 	cc := NewKosarajuSharirSCC(G4)
 	assert.LessOrEqual(cc.Count(), 4)
 }
+
+// returns the edges of G as a string
+func edgeString(G *Digraph) string {
+	var s strings.Builder
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			fmt.Fprintf(&s, " %d->%d", v, w)
+		}
+	}
+	return s.String()
+}
+
+func TestDigraphGenerator_Seed(t *testing.T) {
+	assert := assert.New(t)
+
+	// golden digraphs: a change in these means that seeded digraphs are no longer reproducible
+	generator := NewDigraphGeneratorSeed(2021)
+	simple, _ := generator.Simple(5, 6)
+	assert.Equal(" 0->4 1->3 2->4 3->0 3->4 4->2", edgeString(simple))
+	dag, _ := generator.Dag(6, 6)
+	assert.Equal(" 1->3 4->3 4->2 5->3 5->1 5->0", edgeString(dag))
+	assert.Equal(" 1->2 1->0 2->0 3->2 3->1 3->0", edgeString(generator.Tournament(4)))
+	tree, _ := generator.RootedOutTree(6)
+	assert.Equal(" 2->3 2->1 2->4 2->0 5->2", edgeString(tree))
+
+	// equal seeds produce identical digraphs, whether given as a seed or as a source
+	generate := func(generator *DigraphGenerator) (digraphs []string) {
+		simpleP, _ := generator.SimpleP(10, 0.3)
+		inDAG, _ := generator.RootedInDAG(8, 12)
+		cycle, _ := generator.EulerianCycleDigraph(6, 9)
+		path, _ := generator.EulerianPathDigraph(6, 9)
+		strong, _ := generator.StrongDigraph(10, 25, 3)
+		for _, G := range []*Digraph{simpleP, inDAG, cycle, path, strong, generator.CompleteRootedOutDAG(5),
+			generator.PathDigraph(8), generator.BinaryTree(8), generator.CycleDigraph(8)} {
+			digraphs = append(digraphs, edgeString(G))
+		}
+		return digraphs
+	}
+	assert.Equal(generate(NewDigraphGeneratorSeed(7)), generate(NewDigraphGeneratorRand(rand.New(rand.NewSource(7)))))
+	assert.NotEqual(generate(NewDigraphGeneratorSeed(7)), generate(NewDigraphGeneratorSeed(8)))
+}
+
+func TestDigraphGenerator_BarabasiAlbert(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(42)
+	assert := assert.New(t)
+	G, err := generator.BarabasiAlbert(500, 3)
+	assert.Nil(err)
+	assert.Equal(3*(500-3), G.E())
+	assert.False(NewDirectedCycle(G).HasCycle())
+	maxIndegree := 0
+	for v := 0; v < G.V(); v++ {
+		if v >= 3 {
+			assert.Equal(3, G.OutDegree(v))
+		}
+		if G.InDegree(v) > maxIndegree {
+			maxIndegree = G.InDegree(v)
+		}
+	}
+	assert.True(maxIndegree > 30)
+
+	_, err = generator.BarabasiAlbert(5, 5)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_WattsStrogatz(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(42)
+	assert := assert.New(t)
+	G, err := generator.WattsStrogatz(10, 2, 0)
+	assert.Nil(err)
+	for v := 0; v < 10; v++ {
+		assert.ElementsMatch([]int{(v + 1) % 10, (v + 2) % 10}, G.Adj(v))
+	}
+	G, err = generator.WattsStrogatz(200, 3, 0.5)
+	assert.Nil(err)
+	assert.Equal(600, G.E())
+	for v := 0; v < G.V(); v++ {
+		assert.Equal(3, G.OutDegree(v))
+		seen := make(map[int]bool)
+		for _, w := range G.Adj(v) {
+			assert.NotEqual(v, w)
+			assert.False(seen[w])
+			seen[w] = true
+		}
+	}
+
+	_, err = generator.WattsStrogatz(10, 10, 0.5)
+	assert.Error(err)
+	_, err = generator.WattsStrogatz(10, 2, -0.5)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_RMATKronecker(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(42)
+	assert := assert.New(t)
+	G, err := generator.RMAT(8, 2000, 0.57, 0.19, 0.19)
+	assert.Nil(err)
+	assert.Equal(256, G.V())
+	assert.Equal(2000, G.E())
+	assert.True(G.OutDegree(0) > G.OutDegree(255))
+
+	_, err = generator.RMAT(2, 13, 0.25, 0.25, 0.25)
+	assert.EqualError(err, "too many edges")
+	// without the bottom quadrants every edge leaves vertex 0
+	_, err = generator.RMAT(3, 8, 0.5, 0.5, 0)
+	assert.EqualError(err, "too many edges for the probabilities")
+	G, err = generator.RMAT(3, 7, 0.5, 0.5, 0)
+	assert.Nil(err)
+	assert.Equal(7, G.OutDegree(0))
+	_, err = generator.RMAT(3, 1, 0, 0, 0)
+	assert.EqualError(err, "too many edges for the probabilities")
+	// probabilities summing to 1 up to rounding leave the bottom right quadrant unreachable
+	_, err = generator.RMAT(3, 27, 0.7, 0.2, 0.1)
+	assert.EqualError(err, "too many edges for the probabilities")
+	G, err = generator.RMAT(3, 26, 0.7, 0.2, 0.1)
+	assert.Nil(err)
+	assert.Equal(26, G.E())
+
+	G, err = generator.Kronecker([][]float64{{1, 1}, {1, 1}}, 3)
+	assert.Nil(err)
+	assert.Equal(56, G.E())
+	// only edges from vertices whose digits are all 0 or 1 to those whose digits are all 1
+	G, err = generator.Kronecker([][]float64{{0, 1}, {0, 1}}, 2)
+	assert.Nil(err)
+	assert.Equal(3, G.E())
+	assert.Equal(3, G.InDegree(3))
+
+	_, err = generator.Kronecker([][]float64{{0.5, 1.5}, {0, 1}}, 2)
+	assert.Error(err)
+}
+
+func TestDigraphGenerator_ConfigurationModel(t *testing.T) {
+	generator := NewDigraphGeneratorSeed(42)
+	assert := assert.New(t)
+	outdegrees := []int{3, 0, 2, 1, 4}
+	indegrees := []int{1, 4, 2, 3, 0}
+	G, err := generator.ConfigurationModel(outdegrees, indegrees)
+	assert.Nil(err)
+	assert.Equal(10, G.E())
+	for v := range outdegrees {
+		assert.Equal(outdegrees[v], G.OutDegree(v))
+		assert.Equal(indegrees[v], G.InDegree(v))
+	}
+
+	_, err = generator.ConfigurationModel([]int{1, 2}, []int{2, 2})
+	assert.EqualError(err, "sums of outdegrees and indegrees must be equal")
+	_, err = generator.ConfigurationModel([]int{1}, []int{1, 0})
+	assert.Error(err)
+}
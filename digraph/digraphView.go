@@ -0,0 +1,51 @@
+package digraph
+
+import "math"
+
+// DigraphView is the read-only view of a digraph of vertices named 0 through V - 1 that the search, path,
+// depth-first order, cycle, topological, strong-component and transitive-closure types need: the number of
+// vertices and the vertices adjacent from each. Adj panics if the vertex is not between 0 and V - 1.
+// Digraph and CSRDigraph implement it, and so can other representations, such as implicit grids or adjacency
+// stored in a database, to run these algorithms without copying into a Digraph.
+type DigraphView interface {
+	V() int
+	Adj(v int) []int
+}
+
+// EdgeWeightedDigraphView is the read-only view of an edge-weighted digraph that the depth-first order, cycle,
+// topological and shortest-path types need: the number of vertices and the edges pointing from each.
+// Adj panics if the vertex is not between 0 and V - 1. EdgeWeightedDigraph implements it.
+type EdgeWeightedDigraphView interface {
+	V() int
+	Adj(v int) []*DirectedEdge
+}
+
+// returns the reverse of G in compressed sparse row form, with its edges in the order of Digraph.Reverse,
+// so that reversing a large view does not copy it into adjacency lists
+func reverse(G DigraphView) *CSRDigraph {
+	V := G.V()
+	if V > math.MaxInt32 {
+		panic("number of vertices of a CSRDigraph must be at most 2^31 - 1")
+	}
+	R := &CSRDigraph{offsets: make([]int, V+1)}
+	for v := 0; v < V; v++ {
+		for _, w := range G.Adj(v) {
+			R.offsets[w+1]++
+		}
+	}
+	for v := 0; v < V; v++ {
+		R.offsets[v+1] += R.offsets[v]
+	}
+	// counting sort of the edges by head, each list filled from its end, as adding edges to a Digraph
+	// reverses the adjacency lists
+	R.heads = make([]int32, R.offsets[V])
+	next := make([]int, V)
+	copy(next, R.offsets[1:])
+	for v := 0; v < V; v++ {
+		for _, w := range G.Adj(v) {
+			next[w]--
+			R.heads[next[w]] = int32(v)
+		}
+	}
+	return R
+}
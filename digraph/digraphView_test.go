@@ -0,0 +1,76 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gridView is an implicit n-by-n grid digraph with edges right and down, weighing 1 and 2.
+type gridView struct{ n int }
+
+func (g gridView) V() int { return g.n * g.n }
+
+func (g gridView) Adj(v int) (vertices []int) {
+	for _, e := range g.edges(v) {
+		vertices = append(vertices, e.To())
+	}
+	return vertices
+}
+
+func (g gridView) edges(v int) (edges []*DirectedEdge) {
+	if v%g.n+1 < g.n {
+		edges = append(edges, NewDirectedEdge(v, v+1, 1))
+	}
+	if v+g.n < g.V() {
+		edges = append(edges, NewDirectedEdge(v, v+g.n, 2))
+	}
+	return edges
+}
+
+type weightedGridView struct{ gridView }
+
+func (g weightedGridView) Adj(v int) []*DirectedEdge { return g.edges(v) }
+
+func TestDigraphView(t *testing.T) {
+	assert := assert.New(t)
+	var _ DigraphView = (*Digraph)(nil)
+	var _ DigraphView = (*CSRDigraph)(nil)
+	var _ EdgeWeightedDigraphView = (*EdgeWeightedDigraph)(nil)
+
+	// algorithms run on a CSR digraph as on the digraph it holds
+	G, err := NewDigraphGeneratorSeed(48).Simple(40, 120)
+	assert.Nil(err)
+	c := NewCSRDigraph(G)
+	assert.Equal(NewKosarajuSharirSCC(G).Count(), NewKosarajuSharirSCC(c).Count())
+	assert.Equal(NewDepthFirstOrder(G).ReversePost(), NewDepthFirstOrder(c).ReversePost())
+	for v := 0; v < G.V(); v++ {
+		assert.Equal(NewBreadthFirstDirectedPaths(G, 0).DistTo(v), NewBreadthFirstDirectedPaths(c, 0).DistTo(v))
+	}
+	D, err := NewDigraphGeneratorSeed(48).Dag(30, 60)
+	assert.Nil(err)
+	assert.Equal(NewTopological(D).Order(), NewTopological(NewCSRDigraph(D)).Order())
+	// the reverse built for the strong components has the edges of Reverse, in its order
+	G.AddEdge(3, 3)
+	G.AddEdge(3, 5)
+	R := G.Reverse()
+	for _, r := range []*CSRDigraph{reverse(G), reverse(NewCSRDigraph(G))} {
+		assert.Equal(R.E(), r.E())
+		for v := 0; v < G.V(); v++ {
+			assert.Equal(R.Adj(v), r.Adj(v))
+		}
+	}
+
+	// and on an implicit grid
+	grid := gridView{n: 5}
+	assert.True(NewTopological(grid).HasOrder())
+	assert.Equal(8, NewBreadthFirstDirectedPaths(grid, 0).DistTo(24))
+	assert.Equal(25, NewKosarajuSharirSCC(grid).Count())
+	assert.True(NewTransitiveClosure(grid).Reachable(6, 18))
+	assert.False(NewTransitiveClosure(grid).Reachable(18, 6))
+	assert.Equal(12.0, NewDijkstraSP(weightedGridView{grid}, 0).DistTo(24))
+	assert.Equal(12.0, NewBellmanFordSP(weightedGridView{grid}, 0).DistTo(24))
+	sp, err := NewAcyclicSP(weightedGridView{grid}, 0)
+	assert.Nil(err)
+	assert.Equal(12.0, sp.DistTo(24))
+}
@@ -94,3 +94,116 @@ func TestDigraph_String(t *testing.T) {
 	g.AddEdge(0, 2)
 	assert.Equal("3 vertices, 2 edges \n"+"0:  2 1\n"+"1: \n"+"2: \n", g.String())
 }
+
+func TestDigraph_RemoveEdge(t *testing.T) {
+	assert := assert.New(t)
+	g := NewDigraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+
+	assert.True(g.RemoveEdge(0, 1))
+	assert.Equal(2, g.E())
+	assert.Equal(1, g.InDegree(1))
+	assert.True(g.RemoveEdge(0, 1))
+	assert.False(g.RemoveEdge(0, 1))
+	assert.False(g.RemoveEdge(2, 1))
+	assert.Equal(1, g.E())
+	assert.Equal(0, g.OutDegree(0))
+}
+
+func TestDigraph_RemoveVertex(t *testing.T) {
+	assert := assert.New(t)
+	g := NewDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	g.AddEdge(3, 1)
+
+	g.RemoveVertex(1)
+	assert.Equal(3, g.V())
+	assert.Equal(2, g.E())
+	assert.Equal([]int{2}, g.Adj(1))
+	assert.Equal([]int{0}, g.Adj(2))
+	assert.Equal(1, g.InDegree(0))
+	assert.Equal(0, g.InDegree(1))
+
+	// peel the vertices with no incoming edges
+	for v := 0; v < g.V(); {
+		if g.InDegree(v) == 0 {
+			g.RemoveVertex(v)
+			v = 0
+		} else {
+			v++
+		}
+	}
+	assert.Equal(0, g.V())
+}
+
+func TestDigraph_Contract(t *testing.T) {
+	assert := assert.New(t)
+	g := NewDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 1)
+	g.AddEdge(0, 3)
+
+	v := g.Contract(1, 0)
+	assert.Equal(0, v)
+	assert.Equal(3, g.V())
+	assert.Equal(3, g.E())
+	assert.ElementsMatch([]int{1, 2}, g.Adj(0))
+	assert.Equal([]int{0}, g.Adj(2))
+	assert.Equal(1, g.InDegree(0))
+}
+
+func TestDigraph_InducedSubgraph(t *testing.T) {
+	assert := assert.New(t)
+	g := NewDigraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+	g.AddEdge(4, 2)
+
+	sub, index := g.InducedSubgraph([]int{3, 1, 4})
+	assert.Equal([]int{-1, 1, -1, 0, 2}, index)
+	assert.Equal(3, sub.V())
+	assert.Equal(3, sub.E())
+	assert.Equal([]int{2}, sub.Adj(0))
+	assert.Equal([]int{0}, sub.Adj(1))
+	assert.Equal([]int{1}, sub.Adj(2))
+	assert.Equal(5, g.E())
+}
+
+func TestDigraph_RemoveVertices(t *testing.T) {
+	assert := assert.New(t)
+	// peel the sources of a DAG in rounds, as in a topological sort by levels
+	g := NewDigraph(6)
+	for _, e := range [][2]int{{0, 2}, {1, 2}, {2, 3}, {2, 4}, {3, 5}, {4, 5}} {
+		g.AddEdge(e[0], e[1])
+	}
+	var levels []int
+	for g.V() > 0 {
+		var sources []int
+		for v := 0; v < g.V(); v++ {
+			if g.InDegree(v) == 0 {
+				sources = append(sources, v)
+			}
+		}
+		g.RemoveVertices(sources)
+		levels = append(levels, len(sources))
+	}
+	assert.Equal([]int{2, 1, 2, 1}, levels)
+
+	g = NewDigraph(4)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(2, 1)
+	assert.Equal([]int{0, 1, -1, -1}, g.RemoveVertices([]int{3, 2}))
+	assert.Equal(0, g.E())
+	assert.Equal(0, g.InDegree(1))
+	assert.Panics(func() { g.RemoveVertices([]int{2}) })
+}
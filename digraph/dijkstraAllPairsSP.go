@@ -15,7 +15,7 @@ type DijkstraAllPairsSP struct {
 }
 
 // NewDijkstraAllPairsSP computes a shortest paths tree from each vertex to to every other vertex in the edge-weighted digraph G.
-func NewDijkstraAllPairsSP(G *EdgeWeightedDigraph) *DijkstraAllPairsSP {
+func NewDijkstraAllPairsSP(G EdgeWeightedDigraphView) *DijkstraAllPairsSP {
 	pairs := &DijkstraAllPairsSP{all: make([]*DijkstraSP, G.V())}
 	for v := 0; v < G.V(); v++ {
 		pairs.all[v] = NewDijkstraSP(G, v)
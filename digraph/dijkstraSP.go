@@ -23,10 +23,12 @@ type DijkstraSP struct {
 
 // NewDijkstraSP computes a shortest-paths tree from the source vertex s to every other vertex
 // in the edge-weighted digraph G.
-func NewDijkstraSP(G *EdgeWeightedDigraph, s int) *DijkstraSP {
-	for _, e := range G.Edges() {
-		if e.Weight() < 0 {
-			panic(fmt.Sprintln("edge ", e, " has negative weight"))
+func NewDijkstraSP(G EdgeWeightedDigraphView, s int) *DijkstraSP {
+	for v := 0; v < G.V(); v++ {
+		for _, e := range G.Adj(v) {
+			if e.Weight() < 0 {
+				panic(fmt.Sprintln("edge ", e, " has negative weight"))
+			}
 		}
 	}
 	sp := &DijkstraSP{
@@ -16,7 +16,7 @@ type DirectedCycle struct {
 }
 
 // NewDirectedCycle determines whether the digraph G has a directed cycle and, if so, finds such a cycle.
-func NewDirectedCycle(G *Digraph) *DirectedCycle {
+func NewDirectedCycle(G DigraphView) *DirectedCycle {
 	c := &DirectedCycle{
 		marked:  make([]bool, G.V()),
 		edgeTo:  make([]int, G.V()),
@@ -47,7 +47,7 @@ func (c *DirectedCycle) GetCycle() (cy []int) {
 	return cy
 }
 
-func (c *DirectedCycle) dfs(G *Digraph, v int) {
+func (c *DirectedCycle) dfs(G DigraphView, v int) {
 	c.onStack[v] = true
 	c.marked[v] = true
 	for _, w := range G.Adj(v) {
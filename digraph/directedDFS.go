@@ -14,7 +14,7 @@ type DirectedDFS struct {
 }
 
 // NewDirectedDFS computes the vertices in digraph G that are reachable from the source vertex s.
-func NewDirectedDFS(G *Digraph, s int) *DirectedDFS {
+func NewDirectedDFS(G DigraphView, s int) *DirectedDFS {
 	d := &DirectedDFS{marked: make([]bool, G.V()), count: 0}
 	d.validateVertex(s)
 	d.dfs(G, s)
@@ -22,7 +22,7 @@ func NewDirectedDFS(G *Digraph, s int) *DirectedDFS {
 }
 
 // NewDirectedDFSources computes the vertices in digraph G that are connected to any of the source vertices sources.
-func NewDirectedDFSources(G *Digraph, sources []int) *DirectedDFS {
+func NewDirectedDFSources(G DigraphView, sources []int) *DirectedDFS {
 	d := &DirectedDFS{marked: make([]bool, G.V()), count: 0}
 	d.validateVertices(sources)
 	for _, v := range sources {
@@ -33,7 +33,7 @@ func NewDirectedDFSources(G *Digraph, sources []int) *DirectedDFS {
 	return d
 }
 
-func (d *DirectedDFS) dfs(G *Digraph, v int) {
+func (d *DirectedDFS) dfs(G DigraphView, v int) {
 	d.count++
 	d.marked[v] = true
 	for _, w := range G.Adj(v) {
@@ -0,0 +1,315 @@
+package digraph
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/handane123/algorithms/internal/dot"
+	"github.com/pkg/errors"
+)
+
+// DOTHighlight struct represents the parts of a graph to highlight when writing it in the DOT language of Graphviz:
+// vertices, paths and cycles, sets of edges such as minimum spanning trees, and cuts.
+// The highlights come straight from the result types: paths and cycles of vertices from PathTo or GetCycle,
+// paths and cycles of edges from DijkstraSP.PathTo or BellmanFordSP.NegativeCycle, edges from KruskalMST.Edges,
+// and cuts from FordFulkerson.InCut. Edges given as edges match by identity, so of parallel edges only the one in
+// the result is highlighted, while edges given by consecutive vertices match every parallel edge.
+// Highlighted vertices and edges are drawn in red, the vertices on the source side of a cut are filled, and the
+// edges crossing the cut (from its source side, for directed graphs) are drawn dashed in blue.
+// The zero value is not usable; a nil highlight highlights nothing.
+type DOTHighlight struct {
+	vertices map[int]bool
+	pairs    map[[2]int]bool      // v->w for consecutive vertices of paths
+	edges    map[interface{}]bool // highlighted *Edge, *DirectedEdge or *FlowEdge
+	inCut    func(v int) bool
+}
+
+// NewDOTHighlight initializes a highlight of nothing.
+func NewDOTHighlight() *DOTHighlight {
+	return &DOTHighlight{
+		vertices: make(map[int]bool),
+		pairs:    make(map[[2]int]bool),
+		edges:    make(map[interface{}]bool),
+	}
+}
+
+// Vertices highlights the given vertices and returns the highlight.
+func (h *DOTHighlight) Vertices(vertices ...int) *DOTHighlight {
+	for _, v := range vertices {
+		h.vertices[v] = true
+	}
+	return h
+}
+
+// Path highlights the vertices of a path or cycle given as a sequence of vertices, such as DepthFirstDirectedPaths.PathTo
+// or DirectedCycle.GetCycle, and the edges between consecutive ones, and returns the highlight.
+func (h *DOTHighlight) Path(vertices []int) *DOTHighlight {
+	h.Vertices(vertices...)
+	for i := 1; i < len(vertices); i++ {
+		h.pairs[[2]int{vertices[i-1], vertices[i]}] = true
+	}
+	return h
+}
+
+// DirectedEdges highlights the given edges, such as DijkstraSP.PathTo or BellmanFordSP.NegativeCycle,
+// and their endpoints, and returns the highlight.
+func (h *DOTHighlight) DirectedEdges(edges []*DirectedEdge) *DOTHighlight {
+	for _, e := range edges {
+		h.edges[e] = true
+		h.Vertices(e.From(), e.To())
+	}
+	return h
+}
+
+// Edges highlights the given edges, such as KruskalMST.Edges, and their endpoints, and returns the highlight.
+func (h *DOTHighlight) Edges(edges []*Edge) *DOTHighlight {
+	for _, e := range edges {
+		v := e.Either()
+		h.edges[e] = true
+		h.Vertices(v, e.Other(v))
+	}
+	return h
+}
+
+// FlowEdges highlights the given edges of a flow network and their endpoints, and returns the highlight.
+func (h *DOTHighlight) FlowEdges(edges []*FlowEdge) *DOTHighlight {
+	for _, e := range edges {
+		h.edges[e] = true
+		h.Vertices(e.From(), e.To())
+	}
+	return h
+}
+
+// Cut highlights the cut whose source side holds the vertices v for which inCut(v) is true,
+// such as FordFulkerson.InCut, and returns the highlight.
+func (h *DOTHighlight) Cut(inCut func(v int) bool) *DOTHighlight {
+	h.inCut = inCut
+	return h
+}
+
+// returns the attributes of vertex v
+func (h *DOTHighlight) vertexAttributes(v int) (attributes []string) {
+	if h == nil {
+		return nil
+	}
+	if h.vertices[v] {
+		attributes = append(attributes, "color=red", "penwidth=2")
+	}
+	if h.inCut != nil && h.inCut(v) {
+		attributes = append(attributes, "style=filled", "fillcolor=lightblue")
+	}
+	return attributes
+}
+
+// returns the attributes of the edge e from v to w, either way if not directed
+func (h *DOTHighlight) edgeAttributes(e interface{}, v, w int, directed bool) (attributes []string) {
+	if h == nil {
+		return nil
+	}
+	if h.edges[e] || h.pairs[[2]int{v, w}] || !directed && h.pairs[[2]int{w, v}] {
+		attributes = append(attributes, "color=red", "penwidth=2")
+	} else if h.inCut != nil && h.inCut(v) != h.inCut(w) && (!directed || h.inCut(v)) {
+		attributes = append(attributes, "color=blue", "style=dashed")
+	}
+	return attributes
+}
+
+// writes the statements of a graph in the DOT language
+type dotWriter struct {
+	s        strings.Builder
+	directed bool
+	h        *DOTHighlight
+}
+
+func newDOTWriter(V int, directed bool, h *DOTHighlight) *dotWriter {
+	dw := &dotWriter{directed: directed, h: h}
+	if directed {
+		dw.s.WriteString("digraph {\n")
+	} else {
+		dw.s.WriteString("graph {\n")
+	}
+	for v := 0; v < V; v++ {
+		dw.statement(strconv.Itoa(v), h.vertexAttributes(v))
+	}
+	return dw
+}
+
+// writes the edge e from v to w with the given label, if any
+func (dw *dotWriter) edge(e interface{}, v, w int, label string) {
+	var attributes []string
+	if label != "" {
+		attributes = append(attributes, "label="+strconv.Quote(label))
+	}
+	attributes = append(attributes, dw.h.edgeAttributes(e, v, w, dw.directed)...)
+	op := " -- "
+	if dw.directed {
+		op = " -> "
+	}
+	dw.statement(strconv.Itoa(v)+op+strconv.Itoa(w), attributes)
+}
+
+func (dw *dotWriter) statement(s string, attributes []string) {
+	dw.s.WriteString("\t" + s)
+	if len(attributes) > 0 {
+		dw.s.WriteString(" [" + strings.Join(attributes, ", ") + "]")
+	}
+	dw.s.WriteString(";\n")
+}
+
+func (dw *dotWriter) flush(w io.Writer) error {
+	dw.s.WriteString("}\n")
+	_, err := io.WriteString(w, dw.s.String())
+	return err
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// WriteDOT writes this digraph to w in the DOT language with the given highlight, which may be nil.
+// Every vertex and every edge is written, so ReadDigraphDOT reads back the same adjacency lists.
+func (dg *Digraph) WriteDOT(w io.Writer, h *DOTHighlight) error {
+	dw := newDOTWriter(dg.v, true, h)
+	for v := 0; v < dg.v; v++ {
+		// in reverse, as adding edges reverses the adjacency lists
+		adj := dg.Adj(v)
+		for i := len(adj) - 1; i >= 0; i-- {
+			dw.edge(nil, v, adj[i], "")
+		}
+	}
+	return dw.flush(w)
+}
+
+// WriteDOT writes this edge-weighted graph to w in the DOT language with the weights as labels and the given
+// highlight, which may be nil.
+func (wg *EdgeWeightedGraph) WriteDOT(w io.Writer, h *DOTHighlight) error {
+	dw := newDOTWriter(wg.v, false, h)
+	for _, e := range wg.Edges() {
+		v := e.Either()
+		dw.edge(e, v, e.Other(v), formatFloat(e.Weight()))
+	}
+	return dw.flush(w)
+}
+
+// WriteDOT writes this edge-weighted digraph to w in the DOT language with the weights as labels and the given
+// highlight, which may be nil. ReadEdgeWeightedDigraphDOT reads back the same adjacency lists.
+func (wd *EdgeWeightedDigraph) WriteDOT(w io.Writer, h *DOTHighlight) error {
+	dw := newDOTWriter(wd.V(), true, h)
+	for v := 0; v < wd.V(); v++ {
+		adj := wd.Adj(v)
+		for i := len(adj) - 1; i >= 0; i-- {
+			dw.edge(adj[i], v, adj[i].To(), formatFloat(adj[i].Weight()))
+		}
+	}
+	return dw.flush(w)
+}
+
+// WriteDOT writes this flow network to w in the DOT language with labels flow/capacity and the given highlight,
+// which may be nil. ReadFlowNetworkDOT reads back the same edges out of every vertex, in the same order.
+func (fn *FlowNetwork) WriteDOT(w io.Writer, h *DOTHighlight) error {
+	dw := newDOTWriter(fn.v, true, h)
+	for v := 0; v < fn.v; v++ {
+		selfLoops := 0
+		adj := fn.adj[v].Values()
+		for i := len(adj) - 1; i >= 0; i-- {
+			e := adj[i].(*FlowEdge)
+			if e.From() != v {
+				continue
+			}
+			// a self loop is in the list twice
+			if e.To() == v {
+				selfLoops++
+				if selfLoops%2 == 0 {
+					continue
+				}
+			}
+			dw.edge(e, v, e.To(), formatFloat(e.Flow())+"/"+formatFloat(e.Capacity()))
+		}
+	}
+	return dw.flush(w)
+}
+
+func parseDOTWeight(e dot.Edge) (float64, error) {
+	weight, err := strconv.ParseFloat(e.Label, 64)
+	if err != nil {
+		return 0, errors.Errorf("edge %d-%d has no weight label", e.V, e.W)
+	}
+	return weight, nil
+}
+
+// ReadDigraphDOT reads a digraph written by Digraph.WriteDOT, ignoring the attributes.
+// Every vertex below the largest one must be named in the input.
+func ReadDigraphDOT(r io.Reader) (*Digraph, error) {
+	V, edges, err := dot.Read(r, true)
+	if err != nil {
+		return nil, err
+	}
+	G := NewDigraph(V)
+	for _, e := range edges {
+		G.AddEdge(e.V, e.W)
+	}
+	return G, nil
+}
+
+// ReadEdgeWeightedGraphDOT reads an edge-weighted graph written by EdgeWeightedGraph.WriteDOT,
+// taking the weights from the labels.
+func ReadEdgeWeightedGraphDOT(r io.Reader) (*EdgeWeightedGraph, error) {
+	V, edges, err := dot.Read(r, false)
+	if err != nil {
+		return nil, err
+	}
+	G := NewEdgeWeightedGraphV(V)
+	for _, e := range edges {
+		weight, err := parseDOTWeight(e)
+		if err != nil {
+			return nil, err
+		}
+		G.AddEdge(NewEdge(e.V, e.W, weight))
+	}
+	return G, nil
+}
+
+// ReadEdgeWeightedDigraphDOT reads an edge-weighted digraph written by EdgeWeightedDigraph.WriteDOT,
+// taking the weights from the labels.
+func ReadEdgeWeightedDigraphDOT(r io.Reader) (*EdgeWeightedDigraph, error) {
+	V, edges, err := dot.Read(r, true)
+	if err != nil {
+		return nil, err
+	}
+	G := NewEdgeWeightedDigraphV(V)
+	for _, e := range edges {
+		weight, err := parseDOTWeight(e)
+		if err != nil {
+			return nil, err
+		}
+		G.AddEdge(NewDirectedEdge(e.V, e.W, weight))
+	}
+	return G, nil
+}
+
+// ReadFlowNetworkDOT reads a flow network written by FlowNetwork.WriteDOT, taking the flows and capacities
+// from the labels flow/capacity, or only the capacities from labels without a slash.
+func ReadFlowNetworkDOT(r io.Reader) (*FlowNetwork, error) {
+	V, edges, err := dot.Read(r, true)
+	if err != nil {
+		return nil, err
+	}
+	G := NewFlowNetwork(V)
+	for _, e := range edges {
+		flow, capacity := "0", e.Label
+		if i := strings.IndexByte(e.Label, '/'); i >= 0 {
+			flow, capacity = e.Label[:i], e.Label[i+1:]
+		}
+		f, err1 := strconv.ParseFloat(flow, 64)
+		c, err2 := strconv.ParseFloat(capacity, 64)
+		if err1 != nil || err2 != nil {
+			return nil, errors.Errorf("edge %d->%d has no flow/capacity label", e.V, e.W)
+		}
+		if !(c >= 0) || !(f >= 0) || !(f <= c) {
+			return nil, errors.Errorf("edge %d->%d has flow %s and capacity %s", e.V, e.W, flow, capacity)
+		}
+		G.AddEdge(NewFlowEdgeF(e.V, e.W, c, f))
+	}
+	return G, nil
+}
@@ -0,0 +1,109 @@
+package digraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigraph_WriteDOT(t *testing.T) {
+	assert := assert.New(t)
+	G := NewDigraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 0)
+	G.AddEdge(2, 3)
+
+	var s strings.Builder
+	assert.Nil(G.WriteDOT(&s, NewDOTHighlight().Path(NewDirectedCycle(G).GetCycle())))
+	assert.Equal("digraph {\n"+
+		"\t0 [color=red, penwidth=2];\n"+
+		"\t1 [color=red, penwidth=2];\n"+
+		"\t2 [color=red, penwidth=2];\n"+
+		"\t3;\n"+
+		"\t0 -> 1 [color=red, penwidth=2];\n"+
+		"\t1 -> 2 [color=red, penwidth=2];\n"+
+		"\t2 -> 0 [color=red, penwidth=2];\n"+
+		"\t2 -> 3;\n"+
+		"}\n", s.String())
+
+	H, err := ReadDigraphDOT(strings.NewReader(s.String()))
+	assert.Nil(err)
+	assert.Equal(G.String(), H.String())
+
+	_, err = ReadDigraphDOT(strings.NewReader("graph {\n}\n"))
+	assert.Error(err)
+	_, err = ReadDigraphDOT(strings.NewReader("digraph {\n\t0 -> x;\n}\n"))
+	assert.Error(err)
+	_, err = ReadDigraphDOT(strings.NewReader("digraph {\n\t0 -> 1;\n"))
+	assert.Error(err)
+}
+
+func TestEdgeWeightedDigraph_WriteDOT(t *testing.T) {
+	assert := assert.New(t)
+	G, err := NewDigraphGeneratorSeed(45).EdgeWeightedSimple(8, 20, 0, 1)
+	assert.Nil(err)
+	path := NewDijkstraSP(G, 0).PathTo(G.Edges()[0].To())
+
+	var s strings.Builder
+	assert.Nil(G.WriteDOT(&s, NewDOTHighlight().DirectedEdges(path)))
+	assert.NotEmpty(path)
+	assert.Equal(len(path), strings.Count(s.String(), "\", color=red"))
+
+	H, err := ReadEdgeWeightedDigraphDOT(strings.NewReader(s.String()))
+	assert.Nil(err)
+	assert.Equal(G.String(), H.String())
+
+	_, err = ReadEdgeWeightedDigraphDOT(strings.NewReader("digraph {\n\t0 -> 1;\n}\n"))
+	assert.Error(err)
+}
+
+func TestEdgeWeightedGraph_WriteDOT(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(3)
+	G.AddEdge(NewEdge(0, 1, 0.5))
+	G.AddEdge(NewEdge(1, 2, 0.25))
+	G.AddEdge(NewEdge(0, 2, 1))
+
+	var s strings.Builder
+	assert.Nil(G.WriteDOT(&s, NewDOTHighlight().Edges(NewKruskalMST(G).Edges())))
+	assert.Contains(s.String(), "\t0 -- 1 [label=\"0.5\", color=red, penwidth=2];\n")
+	assert.Contains(s.String(), "\t0 -- 2 [label=\"1\"];\n")
+
+	H, err := ReadEdgeWeightedGraphDOT(strings.NewReader(s.String()))
+	assert.Nil(err)
+	assert.Equal(3, H.E())
+	assert.ElementsMatch(edgeStrings(G.Edges()), edgeStrings(H.Edges()))
+}
+
+func edgeStrings(edges []*Edge) (s []string) {
+	for _, e := range edges {
+		s = append(s, e.String())
+	}
+	return s
+}
+
+func TestFlowNetwork_WriteDOT(t *testing.T) {
+	assert := assert.New(t)
+	G := NewFlowNetwork(4)
+	G.AddEdge(NewFlowEdge(0, 1, 2))
+	G.AddEdge(NewFlowEdge(0, 2, 1))
+	G.AddEdge(NewFlowEdge(1, 3, 1))
+	G.AddEdge(NewFlowEdge(2, 3, 3))
+	maxflow := NewFordFulkerson(G, 0, 3)
+
+	var s strings.Builder
+	assert.Nil(G.WriteDOT(&s, NewDOTHighlight().Cut(maxflow.InCut)))
+	assert.Contains(s.String(), "\t1 [style=filled, fillcolor=lightblue];\n")
+	assert.Contains(s.String(), "\t1 -> 3 [label=\"1/1\", color=blue, style=dashed];\n")
+	assert.Contains(s.String(), "\t0 -> 2 [label=\"1/1\", color=blue, style=dashed];\n")
+	assert.Contains(s.String(), "\t0 -> 1 [label=\"1/2\"];\n")
+
+	H, err := ReadFlowNetworkDOT(strings.NewReader(s.String()))
+	assert.Nil(err)
+	assert.Equal(G.String(), H.String())
+
+	_, err = ReadFlowNetworkDOT(strings.NewReader("digraph {\n\t0 -> 1 [label=\"2/1\"];\n}\n"))
+	assert.Error(err)
+}
@@ -11,11 +11,14 @@ import (
 )
 
 // EdgeWeightedDigraph struct represents a edge-weighted digraph of vertices named 0 through V - 1,
-// where each directed edge is of type DirectedEdge and has a real-valued weight.
+// where each directed edge is of type DirectedEdge and has a real-valued weight. Besides adding edges, it supports
+// removing edges and vertices, contracting vertices and extracting induced subgraphs.
 // This implementation uses an adjacency-lists representation, which is a vertex-indexed array
 // of Bag objects. It uses O(E + V) space, where E is the number of edges and V is the number
 // of vertices. All instance methods take O(1) time. (Though, iterating over the edges returned
-// by adj(int) takes time proportional to the outdegree of the vertex.) Constructing an empty
+// by adj(int) takes time proportional to the outdegree of the vertex, removing an edge takes time proportional
+// to the outdegree of its tail, and removing or contracting vertices and extracting subgraphs take
+// O(E + V) time, however many vertices are removed at once.) Constructing an empty
 // edge-weighted digraph with V vertices takes O(V) time; constructing an edge-weighted digraph
 // with E edges and V vertices takes O(E + V) time.
 type EdgeWeightedDigraph struct {
@@ -48,11 +51,11 @@ func NewEdgeWeightedDigraphVE(V, E int) *EdgeWeightedDigraph {
 	if E < 0 {
 		panic("number of edges in a digraph must be non negative")
 	}
-	rand.Seed(time.Now().UnixNano())
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
 	for i := 0; i < E; i++ {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
-		weight := 0.01 * float64(rand.Intn(100))
+		v := random.Intn(V)
+		w := random.Intn(V)
+		weight := 0.01 * float64(random.Intn(100))
 		edge := NewDirectedEdge(v, w, weight)
 		wd.AddEdge(edge)
 	}
@@ -144,6 +147,97 @@ func (wd *EdgeWeightedDigraph) AddEdge(e *DirectedEdge) {
 	wd.e++
 }
 
+// RemoveEdge removes the directed edge e from this edge-weighted digraph, and returns true if it was in the digraph.
+// Edges are compared by identity, so e must be an edge added to the digraph or returned by it.
+func (wd *EdgeWeightedDigraph) RemoveEdge(e *DirectedEdge) bool {
+	v := e.From()
+	w := e.To()
+	wd.validateVertex(v)
+	wd.validateVertex(w)
+	if !wd.adj[v].Remove(e) {
+		return false
+	}
+	wd.indegree[w]--
+	wd.e--
+	return true
+}
+
+// RemoveVertex removes vertex v and the edges incident on it from this edge-weighted digraph, renumbering every
+// vertex after v down by one so the vertices remain 0 through V - 1. The edges whose endpoints are renumbered
+// are replaced by new edges of the same weight. It takes O(E + V) time; to remove several vertices,
+// use RemoveVertices.
+func (wd *EdgeWeightedDigraph) RemoveVertex(v int) {
+	wd.RemoveVertices([]int{v})
+}
+
+// RemoveVertices removes the given vertices and the edges incident on them from this edge-weighted digraph in one pass,
+// renumbering the remaining vertices in order so they remain 0 through V - 1, and returns the new number of
+// every vertex, -1 for the removed ones. The edges whose endpoints are renumbered are replaced by new edges of
+// the same weight. It takes O(E + V) time in all, however many vertices are removed.
+func (wd *EdgeWeightedDigraph) RemoveVertices(vertices []int) []int {
+	index := removal(wd.v, vertices)
+	*wd = *wd.relabel(index, wd.v-len(vertices))
+	return index
+}
+
+// Contract contracts vertices v and w of this edge-weighted digraph into one: it removes the edges between them,
+// replaces the other edges incident on w by edges of the same weight incident on v, and removes w as RemoveVertex
+// does, keeping parallel edges. It returns the new number of v, and takes O(E + V) time.
+func (wd *EdgeWeightedDigraph) Contract(v, w int) int {
+	wd.validateVertex(v)
+	wd.validateVertex(w)
+	if v == w {
+		panic("cannot contract a vertex with itself")
+	}
+	for _, e := range wd.Adj(v) {
+		if e.To() == w {
+			wd.RemoveEdge(e)
+		}
+	}
+	for _, e := range wd.Adj(w) {
+		if e.To() == v {
+			wd.RemoveEdge(e)
+		}
+	}
+	index := removal(wd.v, []int{w})
+	index[w] = index[v]
+	*wd = *wd.relabel(index, wd.v-1)
+	return index[v]
+}
+
+// InducedSubgraph returns the subdigraph induced by the given vertices, with vertex i for vertices[i] and every
+// edge between them, and the index of every vertex of this digraph in the subdigraph, -1 if it is not in it.
+// The edges whose endpoints are renumbered are new edges of the same weight. It takes O(E + V) time.
+func (wd *EdgeWeightedDigraph) InducedSubgraph(vertices []int) (*EdgeWeightedDigraph, []int) {
+	index := subgraphIndex(wd.v, vertices)
+	return wd.relabel(index, len(vertices)), index
+}
+
+// returns the edge-weighted digraph on V vertices with vertex index[u] for every vertex u of this digraph with
+// index[u] >= 0, and the edges between them in the same order
+func (wd *EdgeWeightedDigraph) relabel(index []int, V int) *EdgeWeightedDigraph {
+	G := NewEdgeWeightedDigraphV(V)
+	for u := 0; u < wd.v; u++ {
+		if index[u] < 0 {
+			continue
+		}
+		// in reverse, as adding edges reverses the adjacency lists
+		values := wd.adj[u].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			e := values[i].(*DirectedEdge)
+			w := e.To()
+			if index[w] < 0 {
+				continue
+			}
+			if index[u] != u || index[w] != w {
+				e = NewDirectedEdge(index[u], index[w], e.Weight())
+			}
+			G.AddEdge(e)
+		}
+	}
+	return G
+}
+
 // String returns a string representation of the edge-weighted digraph.
 func (wd *EdgeWeightedDigraph) String() string {
 	var s strings.Builder
@@ -118,3 +118,85 @@ func TestEdgeWeightedDigraph(t *testing.T) {
 	assert.PanicsWithValue("number of vertices in a digraph must be non negative",
 		func() { NewEdgeWeightedDigraphIn(in3) })
 }
+
+func TestEdgeWeightedDigraph_RemoveEdge(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedDigraphV(3)
+	e := NewDirectedEdge(0, 1, 0.5)
+	G.AddEdge(e)
+	G.AddEdge(NewDirectedEdge(1, 2, 1.5))
+
+	assert.True(G.RemoveEdge(e))
+	assert.False(G.RemoveEdge(e))
+	assert.Equal(1, G.E())
+	assert.Equal(0, G.OutDegree(0))
+	assert.Equal(0, G.InDegree(1))
+}
+
+func TestEdgeWeightedDigraph_RemoveVertex(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedDigraphV(3)
+	G.AddEdge(NewDirectedEdge(0, 1, 1))
+	G.AddEdge(NewDirectedEdge(1, 2, 2))
+	G.AddEdge(NewDirectedEdge(2, 0, 3))
+	G.AddEdge(NewDirectedEdge(0, 2, 4))
+
+	G.RemoveVertex(1)
+	assert.Equal(2, G.V())
+	assert.Equal(2, G.E())
+	e := G.Adj(1)[0]
+	assert.Equal(1, e.From())
+	assert.Equal(0, e.To())
+	assert.Equal(3.0, e.Weight())
+	assert.Equal(1, G.InDegree(1))
+}
+
+func TestEdgeWeightedDigraph_Contract(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedDigraphV(3)
+	G.AddEdge(NewDirectedEdge(0, 1, 1))
+	G.AddEdge(NewDirectedEdge(1, 0, 2))
+	G.AddEdge(NewDirectedEdge(1, 2, 3))
+	G.AddEdge(NewDirectedEdge(2, 0, 4))
+
+	v := G.Contract(0, 1)
+	assert.Equal(0, v)
+	assert.Equal(2, G.E())
+	e := G.Adj(0)[0]
+	assert.Equal(1, e.To())
+	assert.Equal(3.0, e.Weight())
+	assert.Equal(1, G.InDegree(0))
+}
+
+func TestEdgeWeightedDigraph_InducedSubgraph(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedDigraphV(3)
+	G.AddEdge(NewDirectedEdge(0, 1, 1))
+	G.AddEdge(NewDirectedEdge(1, 2, 2))
+	G.AddEdge(NewDirectedEdge(2, 1, 3))
+
+	sub, index := G.InducedSubgraph([]int{2, 1})
+	assert.Equal([]int{-1, 1, 0}, index)
+	assert.Equal(2, sub.E())
+	assert.Equal(2.0, sub.Adj(1)[0].Weight())
+	assert.Equal(0, sub.Adj(1)[0].To())
+	assert.Equal(1, sub.InDegree(0))
+	assert.Equal(3, G.E())
+}
+
+func TestEdgeWeightedDigraph_RemoveVertices(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedDigraphV(4)
+	G.AddEdge(NewDirectedEdge(0, 3, 1))
+	G.AddEdge(NewDirectedEdge(3, 2, 2))
+	G.AddEdge(NewDirectedEdge(1, 3, 3))
+
+	assert.Equal([]int{-1, 0, 1, 2}, G.RemoveVertices([]int{0}))
+	assert.Equal(2, G.E())
+	e := G.Adj(0)[0]
+	assert.Equal(2, e.To())
+	assert.Equal(3.0, e.Weight())
+	assert.Equal(1, G.InDegree(2))
+	assert.Equal([]int{-1, 0, -1}, G.RemoveVertices([]int{0, 2}))
+	assert.Equal(0, G.E())
+}
@@ -16,7 +16,7 @@ type EdgeWeightedDirectedCycle struct {
 }
 
 // NewEdgeWeightedDirectedCycle determines whether the edge-weighted digraph G has a directed cycle and, if so, finds such a cycle.
-func NewEdgeWeightedDirectedCycle(G *EdgeWeightedDigraph) *EdgeWeightedDirectedCycle {
+func NewEdgeWeightedDirectedCycle(G EdgeWeightedDigraphView) *EdgeWeightedDirectedCycle {
 	c := &EdgeWeightedDirectedCycle{
 		marked:  make([]bool, G.V()),
 		edgeTo:  make([]*DirectedEdge, G.V()),
@@ -47,7 +47,7 @@ func (c *EdgeWeightedDirectedCycle) GetCycle() (cy []*DirectedEdge) {
 	return cy
 }
 
-func (c *EdgeWeightedDirectedCycle) dfs(G *EdgeWeightedDigraph, v int) {
+func (c *EdgeWeightedDirectedCycle) dfs(G EdgeWeightedDigraphView, v int) {
 	c.onStack[v] = true
 	c.marked[v] = true
 	for _, e := range G.Adj(v) {
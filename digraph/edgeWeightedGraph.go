@@ -12,11 +12,14 @@ import (
 )
 
 // EdgeWeightedGraph struct represents an edge-weighted graph of vertices named 0 through V – 1,
-// where each undirected edge is of type Edge and has a real-valued weight.
+// where each undirected edge is of type Edge and has a real-valued weight. Besides adding edges, it supports
+// removing edges and vertices, contracting vertices and extracting induced subgraphs.
 // This implementation uses an adjacency-lists representation, which is a vertex-indexed array
 // of Bag objects. It uses O(E + V) space, where E is the number of edges and V is the number
 // of vertices. All instance methods take O(1) time. (Though, iterating over the edges returned
-// by adj(int) takes time proportional to the degree of the vertex.) Constructing an empty
+// by adj(int) takes time proportional to the degree of the vertex, removing an edge takes time proportional
+// to the degrees of its endpoints, and removing or contracting vertices and extracting subgraphs take
+// O(E + V) time, however many vertices are removed at once.) Constructing an empty
 // edge-weighted graph with V vertices takes O(V) time; constructing a edge-weighted graph
 // with E edges and V vertices takes O(E + V) time.
 type EdgeWeightedGraph struct {
@@ -48,11 +51,11 @@ func NewEdgeWeightedGraphVE(V, E int) *EdgeWeightedGraph {
 	if E < 0 {
 		panic("Number of edges must be non negative")
 	}
-	rand.Seed(time.Now().UnixNano())
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
 	for i := 0; i < E; i++ {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
-		weight := math.Round(100*rand.Float64()) / 100.0
+		v := random.Intn(V)
+		w := random.Intn(V)
+		weight := math.Round(100*random.Float64()) / 100.0
 		e := NewEdge(v, w, weight)
 		wg.AddEdge(e)
 	}
@@ -114,6 +117,99 @@ func (wg *EdgeWeightedGraph) AddEdge(e *Edge) {
 	wg.e++
 }
 
+// RemoveEdge removes the edge e from this edge-weighted graph, and returns true if it was in the graph.
+// Edges are compared by identity, so e must be an edge added to the graph or returned by it.
+func (wg *EdgeWeightedGraph) RemoveEdge(e *Edge) bool {
+	v := e.Either()
+	w := e.Other(v)
+	wg.validateVertex(v)
+	wg.validateVertex(w)
+	if !wg.adj[v].Remove(e) {
+		return false
+	}
+	wg.adj[w].Remove(e) // the second occurrence of a self loop
+	wg.e--
+	return true
+}
+
+// RemoveVertex removes vertex v and the edges incident on it from this edge-weighted graph, renumbering every
+// vertex after v down by one so the vertices remain 0 through V - 1. The edges whose endpoints are renumbered
+// are replaced by new edges of the same weight. It takes O(E + V) time; to remove several vertices,
+// use RemoveVertices.
+func (wg *EdgeWeightedGraph) RemoveVertex(v int) {
+	wg.RemoveVertices([]int{v})
+}
+
+// RemoveVertices removes the given vertices and the edges incident on them from this edge-weighted graph in one pass,
+// renumbering the remaining vertices in order so they remain 0 through V - 1, and returns the new number of
+// every vertex, -1 for the removed ones. The edges whose endpoints are renumbered are replaced by new edges of
+// the same weight. It takes O(E + V) time in all, however many vertices are removed.
+func (wg *EdgeWeightedGraph) RemoveVertices(vertices []int) []int {
+	index := removal(wg.v, vertices)
+	*wg = *wg.relabel(index, wg.v-len(vertices))
+	return index
+}
+
+// Contract contracts vertices v and w of this edge-weighted graph into one: it removes the edges between them,
+// replaces the other edges incident on w by edges of the same weight incident on v, and removes w as RemoveVertex
+// does, keeping parallel edges. It returns the new number of v, and takes O(E + V) time.
+func (wg *EdgeWeightedGraph) Contract(v, w int) int {
+	wg.validateVertex(v)
+	wg.validateVertex(w)
+	if v == w {
+		panic("cannot contract a vertex with itself")
+	}
+	for _, e := range wg.Adj(v) {
+		if e.Other(v) == w {
+			wg.RemoveEdge(e)
+		}
+	}
+	index := removal(wg.v, []int{w})
+	index[w] = index[v]
+	*wg = *wg.relabel(index, wg.v-1)
+	return index[v]
+}
+
+// InducedSubgraph returns the subgraph induced by the given vertices, with vertex i for vertices[i] and every
+// edge between them, and the index of every vertex of this graph in the subgraph, -1 if it is not in it.
+// The edges whose endpoints are renumbered are new edges of the same weight. It takes O(E + V) time.
+func (wg *EdgeWeightedGraph) InducedSubgraph(vertices []int) (*EdgeWeightedGraph, []int) {
+	index := subgraphIndex(wg.v, vertices)
+	return wg.relabel(index, len(vertices)), index
+}
+
+// returns the edge-weighted graph on V vertices with vertex index[u] for every vertex u of this graph with
+// index[u] >= 0, and the edges between them in the same order
+func (wg *EdgeWeightedGraph) relabel(index []int, V int) *EdgeWeightedGraph {
+	G := NewEdgeWeightedGraphV(V)
+	relabeled := make(map[*Edge]*Edge)
+	for u := 0; u < wg.v; u++ {
+		if index[u] < 0 {
+			continue
+		}
+		// in reverse, as adding edges reverses the adjacency lists
+		values := wg.adj[u].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			e := values[i].(*Edge)
+			x := e.Other(u)
+			if index[x] < 0 {
+				continue
+			}
+			f, ok := relabeled[e]
+			if !ok {
+				f = e
+				if index[u] != u || index[x] != x {
+					f = NewEdge(index[u], index[x], e.Weight())
+				}
+				relabeled[e] = f
+				G.e++
+			}
+			G.adj[index[u]].Add(f)
+		}
+	}
+	return G
+}
+
 // Adj returns the edges incident on vertex v.
 func (wg *EdgeWeightedGraph) Adj(v int) (edges []*Edge) {
 	wg.validateVertex(v)
@@ -110,3 +110,115 @@ func TestEdgeWeightedGraph(t *testing.T) {
 	}
 	assert.Equal(edges2, G4.Edges())
 }
+
+func TestEdgeWeightedGraph_RemoveEdge(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(3)
+	e := NewEdge(0, 1, 0.5)
+	loop := NewEdge(2, 2, 1.0)
+	G.AddEdge(e)
+	G.AddEdge(NewEdge(0, 1, 0.5))
+	G.AddEdge(loop)
+
+	assert.True(G.RemoveEdge(e))
+	assert.False(G.RemoveEdge(e))
+	assert.Equal(1, G.Degree(0))
+	assert.True(G.RemoveEdge(loop))
+	assert.Equal(0, G.Degree(2))
+	assert.Equal(1, G.E())
+}
+
+func TestEdgeWeightedGraph_RemoveVertex(t *testing.T) {
+	assert := assert.New(t)
+	// a triangle 0-1-2 with the tail 2-3-4
+	G := NewEdgeWeightedGraphV(5)
+	G.AddEdge(NewEdge(0, 1, 1))
+	G.AddEdge(NewEdge(1, 2, 2))
+	G.AddEdge(NewEdge(2, 0, 3))
+	G.AddEdge(NewEdge(2, 3, 4))
+	G.AddEdge(NewEdge(3, 4, 5))
+
+	// peel the 2-core
+	for v := 0; v < G.V(); {
+		if G.Degree(v) < 2 {
+			G.RemoveVertex(v)
+			v = 0
+		} else {
+			v++
+		}
+	}
+	assert.Equal(3, G.V())
+	assert.Equal(3, G.E())
+	weight := 0.0
+	for _, e := range G.Edges() {
+		v := e.Either()
+		assert.True(v < 3 && e.Other(v) < 3)
+		weight += e.Weight()
+	}
+	assert.Equal(6.0, weight)
+	for v := 0; v < G.V(); v++ {
+		for _, e := range G.Adj(v) {
+			assert.Contains(G.Adj(e.Other(v)), e)
+		}
+	}
+}
+
+func TestEdgeWeightedGraph_Contract(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(4)
+	G.AddEdge(NewEdge(0, 1, 1))
+	G.AddEdge(NewEdge(1, 2, 2))
+	G.AddEdge(NewEdge(2, 3, 3))
+	G.AddEdge(NewEdge(3, 0, 4))
+
+	v := G.Contract(2, 1)
+	assert.Equal(1, v)
+	assert.Equal(3, G.V())
+	assert.Equal(3, G.E())
+	assert.Equal(2, G.Degree(1))
+	for _, e := range G.Adj(0) {
+		if e.Weight() == 1 {
+			assert.Equal(1, e.Other(0))
+		}
+	}
+}
+
+func TestEdgeWeightedGraph_InducedSubgraph(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(4)
+	e := NewEdge(0, 1, 1)
+	G.AddEdge(e)
+	G.AddEdge(NewEdge(1, 2, 2))
+	G.AddEdge(NewEdge(2, 3, 3))
+
+	sub, index := G.InducedSubgraph([]int{0, 1, 3})
+	assert.Equal([]int{0, 1, -1, 2}, index)
+	assert.Equal(1, sub.E())
+	assert.Equal([]*Edge{e}, sub.Adj(0))
+	assert.Equal(0, sub.Degree(2))
+
+	sub, _ = G.InducedSubgraph([]int{3, 2})
+	f := sub.Adj(0)[0]
+	assert.Equal(3.0, f.Weight())
+	assert.Equal([]*Edge{f}, sub.Adj(1))
+	assert.Equal(3, G.E())
+}
+
+func TestEdgeWeightedGraph_RemoveVertices(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(5)
+	e := NewEdge(0, 1, 1)
+	G.AddEdge(e)
+	G.AddEdge(NewEdge(1, 4, 2))
+	G.AddEdge(NewEdge(2, 4, 3))
+	G.AddEdge(NewEdge(3, 3, 4))
+
+	assert.Equal([]int{0, 1, -1, -1, 2}, G.RemoveVertices([]int{3, 2}))
+	assert.Equal(3, G.V())
+	assert.Equal(2, G.E())
+	assert.Equal([]*Edge{e}, G.Adj(0))
+	f := G.Adj(2)[0]
+	assert.Equal(2.0, f.Weight())
+	assert.Equal(1, f.Other(2))
+	assert.Contains(G.Adj(1), f)
+}
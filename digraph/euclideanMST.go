@@ -0,0 +1,92 @@
+package digraph
+
+import (
+	"math"
+
+	"github.com/handane123/algorithms/fundamentals/geometry"
+)
+
+// number of cones of directions around each point; cones must be at most π/3 wide
+// for the Yao graph to contain a Euclidean minimum spanning tree
+const yaoCones = 8
+
+// NewEuclideanGraph returns a sparse edge-weighted graph on the given points, where vertex i is points[i]
+// and the weight of each edge is the Euclidean distance between its endpoints.
+// The graph is the Yao graph: the plane around each point is divided into 8 cones of directions and the
+// point is connected to its nearest neighbor in each cone. It has at most 8n edges, where n is the number
+// of points, and contains a Euclidean minimum spanning tree of the points.
+// Points with equal coordinates, including the same point given more than once, are joined to the first of
+// them by an edge of weight 0, and only the first takes part in the Yao graph.
+// The nearest neighbors are found with a KdTree, which takes O(n log n) time for typical inputs.
+func NewEuclideanGraph(points []*geometry.Point2D) *EdgeWeightedGraph {
+	G := NewEdgeWeightedGraphV(len(points))
+	first := make(map[[2]float64]int)
+	var distinct []*geometry.Point2D
+	var vertex []int // vertex[i] = vertex of distinct[i]
+	for v, p := range points {
+		key := [2]float64{p.X(), p.Y()}
+		if u, ok := first[key]; ok {
+			G.AddEdge(NewEdge(u, v, 0))
+			continue
+		}
+		first[key] = v
+		distinct = append(distinct, p)
+		vertex = append(vertex, v)
+	}
+
+	tree := geometry.NewKdTree(distinct)
+	width := 2 * math.Pi / yaoCones
+	seen := make(map[[2]int]bool)
+	for i, p := range distinct {
+		v := vertex[i]
+		for k := 0; k < yaoCones; k++ {
+			lo := -math.Pi + float64(k)*width
+			j := tree.NearestInCone(i, lo, lo+width)
+			if j < 0 {
+				continue
+			}
+			w := vertex[j]
+			key := [2]int{v, w}
+			if w < v {
+				key = [2]int{w, v}
+			}
+			if !seen[key] {
+				seen[key] = true
+				G.AddEdge(NewEdge(v, w, p.DistanceTo(points[w])))
+			}
+		}
+	}
+	return G
+}
+
+// EuclideanMST struct represents a data type for computing a Euclidean minimum spanning tree of a set of
+// points in the plane: a spanning tree of the complete graph on the points, weighted by Euclidean distance,
+// of minimum total length.
+// This implementation runs KruskalMST on the sparse candidate graph built by NewEuclideanGraph instead of
+// on all n(n-1)/2 pairs of points. The constructor takes O(n log n) time for typical inputs,
+// where n is the number of points. Each instance method takes O(1) time. It uses O(n) extra space.
+type EuclideanMST struct {
+	graph *EdgeWeightedGraph // sparse candidate graph
+	mst   *KruskalMST        // minimum spanning tree of the candidate graph
+}
+
+// NewEuclideanMST computes a Euclidean minimum spanning tree of the given points.
+func NewEuclideanMST(points []*geometry.Point2D) *EuclideanMST {
+	G := NewEuclideanGraph(points)
+	return &EuclideanMST{graph: G, mst: NewKruskalMST(G)}
+}
+
+// Graph returns the sparse candidate graph the tree was computed from.
+func (emst *EuclideanMST) Graph() *EdgeWeightedGraph {
+	return emst.graph
+}
+
+// Edges returns the edges in a Euclidean minimum spanning tree; vertex i is the i-th point.
+func (emst *EuclideanMST) Edges() []*Edge {
+	return emst.mst.Edges()
+}
+
+// Weight returns the total length of a Euclidean minimum spanning tree.
+func (emst *EuclideanMST) Weight() float64 {
+	return emst.mst.Weight()
+}
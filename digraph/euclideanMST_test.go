@@ -0,0 +1,53 @@
+package digraph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/handane123/algorithms/fundamentals/geometry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEuclideanMST(t *testing.T) {
+	assert := assert.New(t)
+
+	// unit square with its center
+	points := []*geometry.Point2D{
+		geometry.NewPoint2D(0, 0),
+		geometry.NewPoint2D(1, 0),
+		geometry.NewPoint2D(1, 1),
+		geometry.NewPoint2D(0, 1),
+		geometry.NewPoint2D(0.5, 0.5),
+	}
+	emst := NewEuclideanMST(points)
+	assert.Len(emst.Edges(), 4)
+	assert.InEpsilon(2*1.4142135623730951, emst.Weight(), 1e-9)
+	for _, e := range emst.Edges() {
+		v := e.Either()
+		assert.True(v == 4 || e.Other(v) == 4)
+	}
+	assert.Equal(0, NewEuclideanMST(nil).Graph().V())
+
+	// the same point given twice, and four points with equal coordinates, are still spanned
+	points = append(points, points[0], geometry.NewPoint2D(1, 1), geometry.NewPoint2D(1, 1), points[2])
+	emst = NewEuclideanMST(points)
+	assert.Len(emst.Edges(), len(points)-1)
+	assert.InEpsilon(2*1.4142135623730951, emst.Weight(), 1e-9)
+
+	// compare with the minimum spanning tree of the complete graph
+	r := rand.New(rand.NewSource(11))
+	points = make([]*geometry.Point2D, 200)
+	for i := range points {
+		points[i] = geometry.NewPoint2D(r.Float64(), r.Float64())
+	}
+	complete := NewEdgeWeightedGraphV(len(points))
+	for v := range points {
+		for w := v + 1; w < len(points); w++ {
+			complete.AddEdge(NewEdge(v, w, points[v].DistanceTo(points[w])))
+		}
+	}
+	emst = NewEuclideanMST(points)
+	assert.Len(emst.Edges(), len(points)-1)
+	assert.LessOrEqual(emst.Graph().E(), 8*len(points))
+	assert.InEpsilon(NewKruskalMST(complete).Weight(), emst.Weight(), 1e-9)
+}
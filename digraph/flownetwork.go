@@ -40,11 +40,11 @@ func NewFlowNetworkVE(V, E int) *FlowNetwork {
 	if E < 0 {
 		panic("number of edges must be non-negative")
 	}
-	rand.Seed(time.Now().UnixNano())
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
 	for i := 0; i < E; i++ {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
-		capacity := float64(rand.Intn(100))
+		v := random.Intn(V)
+		w := random.Intn(V)
+		capacity := float64(random.Intn(100))
 		fn.AddEdge(NewFlowEdge(v, w, capacity))
 	}
 	return fn
@@ -0,0 +1,776 @@
+package digraph
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// The CSV, JSON and GraphML readers and writers exchange every graph type as an edge list: the number of vertices,
+// their names for symbol digraphs, and the edges in the order to add them to rebuild the same adjacency lists
+// (of directed graphs; an undirected graph gets the same edges). Edges carry the values of the columns of their
+// graph type: a weight for edge-weighted graphs, a capacity and a flow for flow networks.
+//
+// The CSV format has a header source,target followed by the columns, then a row per edge, preceded by a row
+// with an empty target for every vertex of a symbol digraph, to keep their order, and for every isolated vertex
+// of the others, to keep the number of vertices. Every vertex of the others must appear in a row, so a file cannot claim
+// more vertices than it has rows.
+//
+// The JSON format is an object {"directed": true, "names": [...], "adjacency": [[{"to": 1, "weight": 0.5}], ...]}
+// with the edges out of every vertex in the order of Adj, and both ends of every undirected edge.
+//
+// The GraphML format has a node per vertex, with the name of a symbol digraph as id, and an edge per edge,
+// with a data element per column.
+
+// edgeList is a graph in the form exchanged by the readers and writers.
+type edgeList struct {
+	V     int
+	names []string // names[v] = name of vertex v, nil for vertices named by their index
+	edges []listEdge
+}
+
+type listEdge struct {
+	v, w   int
+	values []float64 // values of the columns of the format
+}
+
+// edgeListFormat describes the edge lists of a graph type.
+type edgeListFormat struct {
+	directed bool
+	named    bool
+	columns  []string // names of the values of every edge
+	required int      // number of leading columns every edge must have, the others defaulting to 0
+}
+
+var (
+	digraphFormat             = edgeListFormat{directed: true}
+	symbolDigraphFormat       = edgeListFormat{directed: true, named: true}
+	edgeWeightedGraphFormat   = edgeListFormat{columns: []string{"weight"}, required: 1}
+	edgeWeightedDigraphFormat = edgeListFormat{directed: true, columns: []string{"weight"}, required: 1}
+	flowNetworkFormat         = edgeListFormat{directed: true, columns: []string{"capacity", "flow"}, required: 1}
+)
+
+// returns the name of vertex v
+func (l *edgeList) name(v int) string {
+	if l.names == nil {
+		return strconv.Itoa(v)
+	}
+	return l.names[v]
+}
+
+// vertexNames assigns indices to vertex names in the order they are added.
+type vertexNames struct {
+	named bool
+	index map[string]int
+	names []string
+	seen  map[int]bool // vertices named by their index that have been added
+	V     int
+}
+
+func newVertexNames(named bool) *vertexNames {
+	return &vertexNames{named: named, index: make(map[string]int), seen: make(map[int]bool)}
+}
+
+// returns the vertex of the given name, a new one if named, its index otherwise
+func (vn *vertexNames) vertex(name string) (int, error) {
+	if vn.named {
+		v, ok := vn.index[name]
+		if !ok {
+			v = len(vn.names)
+			vn.index[name] = v
+			vn.names = append(vn.names, name)
+			vn.V++
+		}
+		return v, nil
+	}
+	v, err := strconv.Atoi(name)
+	if err != nil || v < 0 {
+		return 0, errors.Errorf("vertex %q is not a non-negative integer", name)
+	}
+	vn.seen[v] = true
+	if v >= vn.V {
+		vn.V = v + 1
+	}
+	return v, nil
+}
+
+// checks that every vertex named by its index below the largest one has been added, so that the number of
+// vertices is bounded by the size of the input rather than by the largest index in it
+func (vn *vertexNames) check() error {
+	if vn.named || len(vn.seen) == vn.V {
+		return nil
+	}
+	// some vertex among the first len(vn.seen) + 1 is missing
+	v := 0
+	for vn.seen[v] {
+		v++
+	}
+	return errors.Errorf("vertex %d is missing, but vertex %d is not", v, vn.V-1)
+}
+
+// CSV
+
+func (l *edgeList) writeCSV(w io.Writer, f edgeListFormat) error {
+	cw := csv.NewWriter(w)
+	record := append([]string{"source", "target"}, f.columns...)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	degree := make([]int, l.V)
+	for _, e := range l.edges {
+		degree[e.v]++
+		degree[e.w]++
+	}
+	for v := 0; v < l.V; v++ {
+		if l.names != nil || degree[v] == 0 {
+			for i := range record {
+				record[i] = ""
+			}
+			record[0] = l.name(v)
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	for _, e := range l.edges {
+		record[0], record[1] = l.name(e.v), l.name(e.w)
+		for i, x := range e.values {
+			record[2+i] = formatFloat(x)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func readCSV(r io.Reader, f edgeListFormat) (*edgeList, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 || header[0] != "source" || header[1] != "target" {
+		return nil, errors.New("header must start with source,target")
+	}
+	// the field of every column, -1 if missing
+	fields := make([]int, len(f.columns))
+	for i, column := range f.columns {
+		fields[i] = -1
+		for j := 2; j < len(header); j++ {
+			if header[j] == column {
+				fields[i] = j
+			}
+		}
+		if fields[i] < 0 && i < f.required {
+			return nil, errors.Errorf("missing %s column", column)
+		}
+	}
+	vn := newVertexNames(f.named)
+	var edges []listEdge
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		v, err := vn.vertex(record[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		if record[1] == "" {
+			continue
+		}
+		e := listEdge{v: v, values: make([]float64, len(f.columns))}
+		if e.w, err = vn.vertex(record[1]); err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		for i, j := range fields {
+			if j < 0 || record[j] == "" && i >= f.required {
+				continue
+			}
+			if e.values[i], err = strconv.ParseFloat(record[j], 64); err != nil {
+				return nil, errors.Errorf("line %d: %s %q is not a number", line, f.columns[i], record[j])
+			}
+		}
+		edges = append(edges, e)
+	}
+	if err := vn.check(); err != nil {
+		return nil, err
+	}
+	l := &edgeList{V: vn.V, edges: edges}
+	if f.named {
+		l.names = vn.names
+	}
+	return l, nil
+}
+
+// JSON
+
+type jsonGraph struct {
+	Directed  bool         `json:"directed"`
+	Names     []string     `json:"names,omitempty"`
+	Adjacency [][]jsonEdge `json:"adjacency"`
+}
+
+type jsonEdge struct {
+	To       int      `json:"to"`
+	Weight   *float64 `json:"weight,omitempty"`
+	Capacity *float64 `json:"capacity,omitempty"`
+	Flow     *float64 `json:"flow,omitempty"`
+}
+
+// returns the field of the edge holding the given column
+func (e *jsonEdge) value(column string) **float64 {
+	switch column {
+	case "weight":
+		return &e.Weight
+	case "capacity":
+		return &e.Capacity
+	default:
+		return &e.Flow
+	}
+}
+
+func (l *edgeList) writeJSON(w io.Writer, f edgeListFormat) error {
+	g := jsonGraph{Directed: f.directed, Names: l.names, Adjacency: make([][]jsonEdge, l.V)}
+	for v := range g.Adjacency {
+		g.Adjacency[v] = []jsonEdge{}
+	}
+	// adding an edge puts it first in the adjacency lists
+	for i := len(l.edges) - 1; i >= 0; i-- {
+		e := l.edges[i]
+		je := jsonEdge{To: e.w}
+		for j, column := range f.columns {
+			x := e.values[j]
+			*je.value(column) = &x
+		}
+		g.Adjacency[e.v] = append(g.Adjacency[e.v], je)
+		if !f.directed {
+			je.To = e.v
+			g.Adjacency[e.w] = append(g.Adjacency[e.w], je)
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+func readJSON(r io.Reader, f edgeListFormat) (*edgeList, error) {
+	var g jsonGraph
+	if err := json.NewDecoder(r).Decode(&g); err != nil {
+		return nil, err
+	}
+	if g.Directed != f.directed {
+		return nil, errors.Errorf("expected directed %t", f.directed)
+	}
+	V := len(g.Adjacency)
+	l := &edgeList{V: V}
+	if f.named {
+		l.names = g.Names
+		if l.names == nil {
+			l.names = make([]string, V)
+			for v := range l.names {
+				l.names[v] = strconv.Itoa(v)
+			}
+		}
+		if len(l.names) != V {
+			return nil, errors.New("expected a name for every vertex")
+		}
+	}
+	// every undirected edge is in both lists, a self loop twice in one
+	count := make(map[[2]int]int)
+	for v, adj := range g.Adjacency {
+		for i := len(adj) - 1; i >= 0; i-- {
+			je := adj[i]
+			if je.To < 0 || je.To >= V {
+				return nil, errors.Errorf("vertex %d is not between 0 and %d", je.To, V-1)
+			}
+			count[[2]int{v, je.To}]++
+			if !f.directed && (je.To < v || je.To == v && count[[2]int{v, v}]%2 == 0) {
+				continue
+			}
+			e := listEdge{v: v, w: je.To, values: make([]float64, len(f.columns))}
+			for j, column := range f.columns {
+				if x := *je.value(column); x != nil {
+					e.values[j] = *x
+				} else if j < f.required {
+					return nil, errors.Errorf("edge %d-%d has no %s", v, je.To, column)
+				}
+			}
+			l.edges = append(l.edges, e)
+		}
+	}
+	if !f.directed {
+		for pair, n := range count {
+			if pair[0] == pair[1] && n%2 != 0 || count[[2]int{pair[1], pair[0]}] != n {
+				return nil, errors.Errorf("edge %d-%d is not in both adjacency lists", pair[0], pair[1])
+			}
+		}
+	}
+	return l, nil
+}
+
+// GraphML
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID string `xml:"id,attr"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func edgeDefault(directed bool) string {
+	if directed {
+		return "directed"
+	}
+	return "undirected"
+}
+
+func (l *edgeList) writeGraphML(w io.Writer, f edgeListFormat) error {
+	g := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{ID: "G", EdgeDefault: edgeDefault(f.directed), Nodes: make([]graphMLNode, l.V)},
+	}
+	for _, column := range f.columns {
+		g.Keys = append(g.Keys, graphMLKey{ID: column, For: "edge", Name: column, Type: "double"})
+	}
+	for v := range g.Graph.Nodes {
+		g.Graph.Nodes[v].ID = l.name(v)
+	}
+	for _, e := range l.edges {
+		ge := graphMLEdge{Source: l.name(e.v), Target: l.name(e.w)}
+		for i, column := range f.columns {
+			ge.Data = append(ge.Data, graphMLData{Key: column, Value: formatFloat(e.values[i])})
+		}
+		g.Graph.Edges = append(g.Graph.Edges, ge)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(g); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readGraphML(r io.Reader, f edgeListFormat) (*edgeList, error) {
+	var g graphML
+	if err := xml.NewDecoder(r).Decode(&g); err != nil {
+		return nil, err
+	}
+	if g.Graph.EdgeDefault != edgeDefault(f.directed) {
+		return nil, errors.Errorf("expected edgedefault %s", edgeDefault(f.directed))
+	}
+	// the names of the keys, which other writers do not name after their attributes
+	names := make(map[string]string)
+	for _, k := range g.Keys {
+		names[k.ID] = k.Name
+	}
+	l := &edgeList{V: len(g.Graph.Nodes)}
+	index := make(map[string]int)
+	for v, node := range g.Graph.Nodes {
+		if _, ok := index[node.ID]; ok {
+			return nil, errors.Errorf("duplicate node %q", node.ID)
+		}
+		index[node.ID] = v
+		if f.named {
+			l.names = append(l.names, node.ID)
+		}
+	}
+	for _, ge := range g.Graph.Edges {
+		e := listEdge{values: make([]float64, len(f.columns))}
+		var ok bool
+		if e.v, ok = index[ge.Source]; !ok {
+			return nil, errors.Errorf("edge from unknown node %q", ge.Source)
+		}
+		if e.w, ok = index[ge.Target]; !ok {
+			return nil, errors.Errorf("edge to unknown node %q", ge.Target)
+		}
+		for i, column := range f.columns {
+			found := false
+			for _, d := range ge.Data {
+				if names[d.Key] == column {
+					x, err := strconv.ParseFloat(d.Value, 64)
+					if err != nil {
+						return nil, errors.Errorf("edge %s-%s: %s %q is not a number", ge.Source, ge.Target, column, d.Value)
+					}
+					e.values[i], found = x, true
+				}
+			}
+			if !found && i < f.required {
+				return nil, errors.Errorf("edge %s-%s has no %s", ge.Source, ge.Target, column)
+			}
+		}
+		l.edges = append(l.edges, e)
+	}
+	return l, nil
+}
+
+// conversions between the graph types and edge lists
+
+func (dg *Digraph) edgeList() *edgeList {
+	l := &edgeList{V: dg.v}
+	for v := 0; v < dg.v; v++ {
+		// in reverse, as adding edges reverses the adjacency lists
+		adj := dg.Adj(v)
+		for i := len(adj) - 1; i >= 0; i-- {
+			l.edges = append(l.edges, listEdge{v: v, w: adj[i]})
+		}
+	}
+	return l
+}
+
+func (l *edgeList) digraph() *Digraph {
+	G := NewDigraph(l.V)
+	for _, e := range l.edges {
+		G.AddEdge(e.v, e.w)
+	}
+	return G
+}
+
+func (sg *SymbolDigraph) edgeList() *edgeList {
+	l := sg.graph.edgeList()
+	l.names = make([]string, len(sg.keys))
+	for v, name := range sg.keys {
+		l.names[v] = string(name)
+	}
+	return l
+}
+
+func (l *edgeList) symbolDigraph() (*SymbolDigraph, error) {
+	return newSymbolDigraphNames(l.names, l.digraph())
+}
+
+func (wg *EdgeWeightedGraph) edgeList() *edgeList {
+	l := &edgeList{V: wg.v}
+	for _, e := range wg.Edges() {
+		v := e.Either()
+		l.edges = append(l.edges, listEdge{v: v, w: e.Other(v), values: []float64{e.Weight()}})
+	}
+	return l
+}
+
+func (l *edgeList) edgeWeightedGraph() *EdgeWeightedGraph {
+	G := NewEdgeWeightedGraphV(l.V)
+	for _, e := range l.edges {
+		G.AddEdge(NewEdge(e.v, e.w, e.values[0]))
+	}
+	return G
+}
+
+func (wd *EdgeWeightedDigraph) edgeList() *edgeList {
+	l := &edgeList{V: wd.V()}
+	for v := 0; v < wd.V(); v++ {
+		adj := wd.Adj(v)
+		for i := len(adj) - 1; i >= 0; i-- {
+			l.edges = append(l.edges, listEdge{v: v, w: adj[i].To(), values: []float64{adj[i].Weight()}})
+		}
+	}
+	return l
+}
+
+func (l *edgeList) edgeWeightedDigraph() *EdgeWeightedDigraph {
+	G := NewEdgeWeightedDigraphV(l.V)
+	for _, e := range l.edges {
+		G.AddEdge(NewDirectedEdge(e.v, e.w, e.values[0]))
+	}
+	return G
+}
+
+func (fn *FlowNetwork) edgeList() *edgeList {
+	l := &edgeList{V: fn.v}
+	for v := 0; v < fn.v; v++ {
+		selfLoops := 0
+		adj := fn.adj[v].Values()
+		for i := len(adj) - 1; i >= 0; i-- {
+			e := adj[i].(*FlowEdge)
+			if e.From() != v {
+				continue
+			}
+			// a self loop is in the list twice
+			if e.To() == v {
+				selfLoops++
+				if selfLoops%2 == 0 {
+					continue
+				}
+			}
+			l.edges = append(l.edges, listEdge{v: v, w: e.To(), values: []float64{e.Capacity(), e.Flow()}})
+		}
+	}
+	return l
+}
+
+func (l *edgeList) flowNetwork() (*FlowNetwork, error) {
+	G := NewFlowNetwork(l.V)
+	for _, e := range l.edges {
+		capacity, flow := e.values[0], e.values[1]
+		if !(capacity >= 0) || !(flow >= 0) || !(flow <= capacity) {
+			return nil, errors.Errorf("edge %d->%d has flow %s and capacity %s",
+				e.v, e.w, formatFloat(flow), formatFloat(capacity))
+		}
+		G.AddEdge(NewFlowEdgeF(e.v, e.w, capacity, flow))
+	}
+	return G, nil
+}
+
+// WriteCSV writes this digraph to w as an edge-list CSV file.
+func (dg *Digraph) WriteCSV(w io.Writer) error {
+	return dg.edgeList().writeCSV(w, digraphFormat)
+}
+
+// WriteJSON writes this digraph to w as JSON adjacency lists.
+func (dg *Digraph) WriteJSON(w io.Writer) error {
+	return dg.edgeList().writeJSON(w, digraphFormat)
+}
+
+// WriteGraphML writes this digraph to w as a GraphML document.
+func (dg *Digraph) WriteGraphML(w io.Writer) error {
+	return dg.edgeList().writeGraphML(w, digraphFormat)
+}
+
+// ReadDigraphCSV reads a digraph from an edge-list CSV file written by Digraph.WriteCSV.
+func ReadDigraphCSV(r io.Reader) (*Digraph, error) {
+	l, err := readCSV(r, digraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.digraph(), nil
+}
+
+// ReadDigraphJSON reads a digraph from JSON adjacency lists written by Digraph.WriteJSON.
+func ReadDigraphJSON(r io.Reader) (*Digraph, error) {
+	l, err := readJSON(r, digraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.digraph(), nil
+}
+
+// ReadDigraphGraphML reads a digraph from a GraphML document written by Digraph.WriteGraphML,
+// numbering the nodes in their order.
+func ReadDigraphGraphML(r io.Reader) (*Digraph, error) {
+	l, err := readGraphML(r, digraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.digraph(), nil
+}
+
+// WriteCSV writes this symbol digraph to w as an edge-list CSV file of vertex names.
+func (sg *SymbolDigraph) WriteCSV(w io.Writer) error {
+	return sg.edgeList().writeCSV(w, symbolDigraphFormat)
+}
+
+// WriteJSON writes this symbol digraph to w as JSON adjacency lists with the vertex names.
+func (sg *SymbolDigraph) WriteJSON(w io.Writer) error {
+	return sg.edgeList().writeJSON(w, symbolDigraphFormat)
+}
+
+// WriteGraphML writes this symbol digraph to w as a GraphML document with the vertex names as node ids.
+func (sg *SymbolDigraph) WriteGraphML(w io.Writer) error {
+	return sg.edgeList().writeGraphML(w, symbolDigraphFormat)
+}
+
+// ReadSymbolDigraphCSV reads a symbol digraph from an edge-list CSV file written by SymbolDigraph.WriteCSV,
+// numbering the vertices in the order their names first appear.
+func ReadSymbolDigraphCSV(r io.Reader) (*SymbolDigraph, error) {
+	l, err := readCSV(r, symbolDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolDigraph()
+}
+
+// ReadSymbolDigraphJSON reads a symbol digraph from JSON adjacency lists written by SymbolDigraph.WriteJSON,
+// naming the vertices by their index if the names are missing.
+func ReadSymbolDigraphJSON(r io.Reader) (*SymbolDigraph, error) {
+	l, err := readJSON(r, symbolDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolDigraph()
+}
+
+// ReadSymbolDigraphGraphML reads a symbol digraph from a GraphML document written by SymbolDigraph.WriteGraphML,
+// naming the vertices by their node ids.
+func ReadSymbolDigraphGraphML(r io.Reader) (*SymbolDigraph, error) {
+	l, err := readGraphML(r, symbolDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolDigraph()
+}
+
+// WriteCSV writes this edge-weighted graph to w as an edge-list CSV file with a weight column.
+func (wg *EdgeWeightedGraph) WriteCSV(w io.Writer) error {
+	return wg.edgeList().writeCSV(w, edgeWeightedGraphFormat)
+}
+
+// WriteJSON writes this edge-weighted graph to w as JSON adjacency lists.
+func (wg *EdgeWeightedGraph) WriteJSON(w io.Writer) error {
+	return wg.edgeList().writeJSON(w, edgeWeightedGraphFormat)
+}
+
+// WriteGraphML writes this edge-weighted graph to w as a GraphML document.
+func (wg *EdgeWeightedGraph) WriteGraphML(w io.Writer) error {
+	return wg.edgeList().writeGraphML(w, edgeWeightedGraphFormat)
+}
+
+// ReadEdgeWeightedGraphCSV reads an edge-weighted graph from an edge-list CSV file with a weight column.
+func ReadEdgeWeightedGraphCSV(r io.Reader) (*EdgeWeightedGraph, error) {
+	l, err := readCSV(r, edgeWeightedGraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedGraph(), nil
+}
+
+// ReadEdgeWeightedGraphJSON reads an edge-weighted graph from JSON adjacency lists written by
+// EdgeWeightedGraph.WriteJSON.
+func ReadEdgeWeightedGraphJSON(r io.Reader) (*EdgeWeightedGraph, error) {
+	l, err := readJSON(r, edgeWeightedGraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedGraph(), nil
+}
+
+// ReadEdgeWeightedGraphGraphML reads an edge-weighted graph from a GraphML document with a weight on every edge.
+func ReadEdgeWeightedGraphGraphML(r io.Reader) (*EdgeWeightedGraph, error) {
+	l, err := readGraphML(r, edgeWeightedGraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedGraph(), nil
+}
+
+// WriteCSV writes this edge-weighted digraph to w as an edge-list CSV file with a weight column.
+func (wd *EdgeWeightedDigraph) WriteCSV(w io.Writer) error {
+	return wd.edgeList().writeCSV(w, edgeWeightedDigraphFormat)
+}
+
+// WriteJSON writes this edge-weighted digraph to w as JSON adjacency lists.
+func (wd *EdgeWeightedDigraph) WriteJSON(w io.Writer) error {
+	return wd.edgeList().writeJSON(w, edgeWeightedDigraphFormat)
+}
+
+// WriteGraphML writes this edge-weighted digraph to w as a GraphML document.
+func (wd *EdgeWeightedDigraph) WriteGraphML(w io.Writer) error {
+	return wd.edgeList().writeGraphML(w, edgeWeightedDigraphFormat)
+}
+
+// ReadEdgeWeightedDigraphCSV reads an edge-weighted digraph from an edge-list CSV file with a weight column.
+func ReadEdgeWeightedDigraphCSV(r io.Reader) (*EdgeWeightedDigraph, error) {
+	l, err := readCSV(r, edgeWeightedDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedDigraph(), nil
+}
+
+// ReadEdgeWeightedDigraphJSON reads an edge-weighted digraph from JSON adjacency lists written by
+// EdgeWeightedDigraph.WriteJSON.
+func ReadEdgeWeightedDigraphJSON(r io.Reader) (*EdgeWeightedDigraph, error) {
+	l, err := readJSON(r, edgeWeightedDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedDigraph(), nil
+}
+
+// ReadEdgeWeightedDigraphGraphML reads an edge-weighted digraph from a GraphML document with a weight on every edge.
+func ReadEdgeWeightedDigraphGraphML(r io.Reader) (*EdgeWeightedDigraph, error) {
+	l, err := readGraphML(r, edgeWeightedDigraphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.edgeWeightedDigraph(), nil
+}
+
+// WriteCSV writes this flow network to w as an edge-list CSV file with capacity and flow columns.
+func (fn *FlowNetwork) WriteCSV(w io.Writer) error {
+	return fn.edgeList().writeCSV(w, flowNetworkFormat)
+}
+
+// WriteJSON writes this flow network to w as JSON adjacency lists of the edges out of every vertex.
+func (fn *FlowNetwork) WriteJSON(w io.Writer) error {
+	return fn.edgeList().writeJSON(w, flowNetworkFormat)
+}
+
+// WriteGraphML writes this flow network to w as a GraphML document.
+func (fn *FlowNetwork) WriteGraphML(w io.Writer) error {
+	return fn.edgeList().writeGraphML(w, flowNetworkFormat)
+}
+
+// ReadFlowNetworkCSV reads a flow network from an edge-list CSV file with a capacity column,
+// and a flow column defaulting to 0.
+func ReadFlowNetworkCSV(r io.Reader) (*FlowNetwork, error) {
+	l, err := readCSV(r, flowNetworkFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.flowNetwork()
+}
+
+// ReadFlowNetworkJSON reads a flow network from JSON adjacency lists written by FlowNetwork.WriteJSON,
+// with flows defaulting to 0.
+func ReadFlowNetworkJSON(r io.Reader) (*FlowNetwork, error) {
+	l, err := readJSON(r, flowNetworkFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.flowNetwork()
+}
+
+// ReadFlowNetworkGraphML reads a flow network from a GraphML document with a capacity on every edge,
+// and flows defaulting to 0.
+func ReadFlowNetworkGraphML(r io.Reader) (*FlowNetwork, error) {
+	l, err := readGraphML(r, flowNetworkFormat)
+	if err != nil {
+		return nil, err
+	}
+	return l.flowNetwork()
+}
@@ -0,0 +1,175 @@
+package digraph
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigraph_WriteCSV(t *testing.T) {
+	assert := assert.New(t)
+	G := NewDigraph(5)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 2)
+	G.AddEdge(2, 0)
+	G.AddEdge(3, 3)
+
+	var b bytes.Buffer
+	assert.Nil(G.WriteCSV(&b))
+	assert.Equal("source,target\n4,\n0,1\n0,2\n2,0\n3,3\n", b.String())
+	H, err := ReadDigraphCSV(&b)
+	assert.Nil(err)
+	assert.Equal(G.String(), H.String())
+
+	write := []func(io.Writer) error{G.WriteJSON, G.WriteGraphML}
+	read := []func(io.Reader) (*Digraph, error){ReadDigraphJSON, ReadDigraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.String(), H.String())
+	}
+
+	_, err = ReadDigraphCSV(strings.NewReader("from,to\n0,1\n"))
+	assert.Error(err)
+	_, err = ReadDigraphCSV(strings.NewReader("source,target\n0,-1\n"))
+	assert.Error(err)
+	_, err = ReadDigraphCSV(strings.NewReader("source,target\n1000000000000,\n"))
+	assert.EqualError(err, "vertex 0 is missing, but vertex 1000000000000 is not")
+	_, err = ReadEdgeWeightedDigraphCSV(strings.NewReader("source,target,weight\n2,0,0.5\n"))
+	assert.EqualError(err, "vertex 1 is missing, but vertex 2 is not")
+	_, err = ReadDigraphJSON(strings.NewReader(`{"directed": false, "adjacency": [[]]}`))
+	assert.Error(err)
+	_, err = ReadDigraphJSON(strings.NewReader(`{"directed": true, "adjacency": [[{"to": 1}]]}`))
+	assert.Error(err)
+}
+
+func TestEdgeWeightedGraph_WriteJSON(t *testing.T) {
+	assert := assert.New(t)
+	G := NewEdgeWeightedGraphV(4)
+	G.AddEdge(NewEdge(0, 1, 0.5))
+	G.AddEdge(NewEdge(1, 2, -1.25))
+	G.AddEdge(NewEdge(2, 2, 3))
+	G.AddEdge(NewEdge(0, 1, 2))
+
+	var b bytes.Buffer
+	assert.Nil(G.WriteJSON(&b))
+	assert.Contains(b.String(), `"weight": -1.25`)
+	write := []func(io.Writer) error{G.WriteCSV, G.WriteJSON, G.WriteGraphML}
+	read := []func(io.Reader) (*EdgeWeightedGraph, error){
+		ReadEdgeWeightedGraphCSV, ReadEdgeWeightedGraphJSON, ReadEdgeWeightedGraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.V(), H.V())
+		assert.ElementsMatch(edgeStrings(G.Edges()), edgeStrings(H.Edges()))
+		for v := 0; v < G.V(); v++ {
+			assert.Equal(G.Degree(v), H.Degree(v))
+		}
+	}
+
+	// an undirected edge must be in both adjacency lists
+	_, err := ReadEdgeWeightedGraphJSON(strings.NewReader(
+		`{"directed": false, "adjacency": [[{"to": 1, "weight": 1}], []]}`))
+	assert.Error(err)
+	_, err = ReadEdgeWeightedGraphCSV(strings.NewReader("source,target\n0,1\n"))
+	assert.Error(err)
+}
+
+func TestEdgeWeightedDigraph_WriteGraphML(t *testing.T) {
+	assert := assert.New(t)
+	G, err := NewDigraphGeneratorSeed(46).EdgeWeightedSimple(10, 30, -1, 1)
+	assert.Nil(err)
+
+	var b bytes.Buffer
+	write := []func(io.Writer) error{G.WriteCSV, G.WriteJSON, G.WriteGraphML}
+	read := []func(io.Reader) (*EdgeWeightedDigraph, error){
+		ReadEdgeWeightedDigraphCSV, ReadEdgeWeightedDigraphJSON, ReadEdgeWeightedDigraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.Edges(), H.Edges())
+	}
+
+	// keys named differently from their attributes, as other tools write them
+	H, err := ReadEdgeWeightedDigraphGraphML(strings.NewReader(`<?xml version="1.0"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"/>
+  <graph id="G" edgedefault="directed">
+    <node id="a"/><node id="b"/>
+    <edge source="b" target="a"><data key="d0">2.5</data></edge>
+  </graph>
+</graphml>`))
+	assert.Nil(err)
+	assert.Equal([]*DirectedEdge{NewDirectedEdge(1, 0, 2.5)}, H.Edges())
+	_, err = ReadEdgeWeightedDigraphGraphML(strings.NewReader(`<graphml><graph edgedefault="directed">
+<node id="a"/><edge source="a" target="c"><data key="weight">1</data></edge></graph></graphml>`))
+	assert.Error(err)
+}
+
+func TestFlowNetwork_WriteCSV(t *testing.T) {
+	assert := assert.New(t)
+	G := NewFlowNetwork(4)
+	G.AddEdge(NewFlowEdge(0, 1, 2))
+	G.AddEdge(NewFlowEdge(0, 2, 1))
+	G.AddEdge(NewFlowEdge(1, 3, 1))
+	G.AddEdge(NewFlowEdge(2, 3, 3))
+	NewFordFulkerson(G, 0, 3)
+
+	var b bytes.Buffer
+	assert.Nil(G.WriteCSV(&b))
+	assert.Contains(b.String(), "source,target,capacity,flow\n0,1,2,1\n")
+	write := []func(io.Writer) error{G.WriteCSV, G.WriteJSON, G.WriteGraphML}
+	read := []func(io.Reader) (*FlowNetwork, error){ReadFlowNetworkCSV, ReadFlowNetworkJSON, ReadFlowNetworkGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.String(), H.String())
+	}
+
+	// flows default to 0
+	H, err := ReadFlowNetworkCSV(strings.NewReader("source,target,capacity\n0,1,2\n"))
+	assert.Nil(err)
+	assert.Equal(0.0, H.Edges()[0].Flow())
+	_, err = ReadFlowNetworkCSV(strings.NewReader("source,target,capacity,flow\n0,1,2,3\n"))
+	assert.Error(err)
+}
+
+func TestSymbolDigraph_WriteCSV(t *testing.T) {
+	assert := assert.New(t)
+	names := []string{"JFK", "ORD", "1", "LAX, CA"}
+	G := NewDigraph(4)
+	G.AddEdge(1, 3)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 3)
+	sg, err := newSymbolDigraphNames(names, G)
+	assert.Nil(err)
+
+	var b bytes.Buffer
+	write := []func(io.Writer) error{sg.WriteCSV, sg.WriteJSON, sg.WriteGraphML}
+	read := []func(io.Reader) (*SymbolDigraph, error){ReadSymbolDigraphCSV, ReadSymbolDigraphJSON, ReadSymbolDigraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.String(), H.Digraph().String())
+		for v, name := range names {
+			assert.Equal(name, H.NameOf(v))
+			assert.Equal(v, H.IndexOf(name))
+		}
+	}
+
+	_, err = ReadSymbolDigraphJSON(strings.NewReader(`{"directed": true, "names": ["a", "a"], "adjacency": [[], []]}`))
+	assert.Error(err)
+}
@@ -0,0 +1,212 @@
+package digraph
+
+import "fmt"
+
+// HeavyLight struct represents a data type for aggregating edge weights along paths of a tree, with updates to
+// single edge weights. The aggregate is given by an associative function and its identity, such as addition and 0
+// for path sums or the maximum and -Inf for path maxima; it need not be commutative, as the weights are combined
+// in the order the path visits them. The constructor panics if the graph is not a tree.
+// This implementation uses heavy-light decomposition: rooted at a given vertex, the tree is split into chains by
+// continuing every chain into the child with the largest subtree, so a path crosses O(log V) chains. Each edge is
+// stored at its lower vertex, and the chains are laid out consecutively in a segment tree that keeps the aggregate
+// of every range in both directions. The constructor takes O(V) time, Query takes O(log^2 V) time, Update takes
+// O(log V) time, and Weight takes O(1) time. It uses O(V) extra space (not including the graph).
+type HeavyLight struct {
+	identity float64
+	combine  func(a, b float64) float64
+	parent   []int // parent[v] = parent of v in the rooted tree, -1 for the root
+	depth    []int // depth[v] = number of edges on the path from the root to v
+	head     []int // head[v] = top vertex of the chain containing v
+	pos      []int // pos[v] = position of v in the segment tree
+	n        int   // number of leaves of the segment tree, a power of 2
+	forward  []float64
+	backward []float64 // aggregates of the segment tree nodes, in position order and in reverse
+}
+
+// NewHeavyLight computes the heavy-light decomposition of the tree G rooted at the given vertex, aggregating
+// the edge weights with the associative function combine, whose identity is the given value.
+func NewHeavyLight(G *EdgeWeightedGraph, root int, identity float64, combine func(a, b float64) float64) *HeavyLight {
+	order, parent, parentEdge := treeOrder(G, root)
+	V := G.V()
+	h := &HeavyLight{
+		identity: identity,
+		combine:  combine,
+		parent:   parent,
+		depth:    make([]int, V),
+		head:     make([]int, V),
+		pos:      make([]int, V),
+	}
+	size := make([]int, V)
+	heavy := make([]int, V) // heavy[v] = child of v with the largest subtree, -1 if v is a leaf
+	for v := range heavy {
+		heavy[v] = -1
+	}
+	for _, v := range order[1:] {
+		h.depth[v] = h.depth[parent[v]] + 1
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		size[v]++
+		if p := parent[v]; p >= 0 {
+			size[p] += size[v]
+			if heavy[p] < 0 || size[v] > size[heavy[p]] {
+				heavy[p] = v
+			}
+		}
+	}
+
+	// lay out the chains, each one from its head down the heavy children
+	h.n = 1
+	for h.n < V {
+		h.n *= 2
+	}
+	h.forward = make([]float64, 2*h.n)
+	h.backward = make([]float64, 2*h.n)
+	for i := range h.forward {
+		h.forward[i], h.backward[i] = identity, identity
+	}
+	next := 0
+	for _, v := range order {
+		if p := parent[v]; p >= 0 && heavy[p] == v {
+			continue
+		}
+		for w := v; w >= 0; w = heavy[w] {
+			h.head[w] = v
+			h.pos[w] = next
+			if parentEdge[w] != nil {
+				h.forward[h.n+next] = parentEdge[w].Weight()
+				h.backward[h.n+next] = parentEdge[w].Weight()
+			}
+			next++
+		}
+	}
+	for i := h.n - 1; i >= 1; i-- {
+		h.pull(i)
+	}
+	return h
+}
+
+// returns the vertices of the tree G in depth-first preorder from the root, the parent of every vertex and the
+// edge to its parent; panics if G is not a tree
+func treeOrder(G *EdgeWeightedGraph, root int) (order, parent []int, parentEdge []*Edge) {
+	G.validateVertex(root)
+	V := G.V()
+	if G.E() != V-1 {
+		panic("graph is not a tree")
+	}
+	parent = make([]int, V)
+	parentEdge = make([]*Edge, V)
+	marked := make([]bool, V)
+	parent[root] = -1
+	marked[root] = true
+	stack := []int{root}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		order = append(order, v)
+		for _, e := range G.Adj(v) {
+			if w := e.Other(v); !marked[w] {
+				marked[w] = true
+				parent[w] = v
+				parentEdge[w] = e
+				stack = append(stack, w)
+			}
+		}
+	}
+	// V - 1 edges reaching every vertex form a spanning tree, so there is no cycle
+	if len(order) != V {
+		panic("graph is not a tree")
+	}
+	return order, parent, parentEdge
+}
+
+func (h *HeavyLight) pull(i int) {
+	h.forward[i] = h.combine(h.forward[2*i], h.forward[2*i+1])
+	h.backward[i] = h.combine(h.backward[2*i+1], h.backward[2*i])
+}
+
+// returns the aggregate of the positions lo through hi, in increasing order if forward is true
+// and in decreasing order otherwise
+func (h *HeavyLight) rangeQuery(lo, hi int, forward bool) float64 {
+	left, right := h.identity, h.identity
+	for lo, hi = lo+h.n, hi+h.n+1; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo&1 == 1 {
+			if forward {
+				left = h.combine(left, h.forward[lo])
+			} else {
+				left = h.combine(h.backward[lo], left)
+			}
+			lo++
+		}
+		if hi&1 == 1 {
+			hi--
+			if forward {
+				right = h.combine(h.forward[hi], right)
+			} else {
+				right = h.combine(right, h.backward[hi])
+			}
+		}
+	}
+	if forward {
+		return h.combine(left, right)
+	}
+	return h.combine(right, left)
+}
+
+// Query returns the aggregate of the weights of the edges on the path from vertex v to vertex w,
+// in the order the path visits them; it returns the identity if v and w are the same vertex.
+func (h *HeavyLight) Query(v, w int) float64 {
+	h.validateVertex(v)
+	h.validateVertex(w)
+	up, down := h.identity, h.identity // aggregates of the path from v up and of the path down to w
+	for h.head[v] != h.head[w] {
+		if h.depth[h.head[v]] >= h.depth[h.head[w]] {
+			up = h.combine(up, h.rangeQuery(h.pos[h.head[v]], h.pos[v], false))
+			v = h.parent[h.head[v]]
+		} else {
+			down = h.combine(h.rangeQuery(h.pos[h.head[w]], h.pos[w], true), down)
+			w = h.parent[h.head[w]]
+		}
+	}
+	// the lower of v and w is now below the other on a chain; the edge above the upper one is not on the path
+	if h.depth[v] > h.depth[w] {
+		up = h.combine(up, h.rangeQuery(h.pos[w]+1, h.pos[v], false))
+	} else if h.depth[w] > h.depth[v] {
+		down = h.combine(h.rangeQuery(h.pos[v]+1, h.pos[w], true), down)
+	}
+	return h.combine(up, down)
+}
+
+// Update sets the weight of the edge between the adjacent vertices v and w.
+func (h *HeavyLight) Update(v, w int, weight float64) {
+	i := h.n + h.pos[h.lower(v, w)]
+	h.forward[i], h.backward[i] = weight, weight
+	for i /= 2; i >= 1; i /= 2 {
+		h.pull(i)
+	}
+}
+
+// Weight returns the weight of the edge between the adjacent vertices v and w.
+func (h *HeavyLight) Weight(v, w int) float64 {
+	return h.forward[h.n+h.pos[h.lower(v, w)]]
+}
+
+// returns whichever of the adjacent vertices v and w is the child of the other
+func (h *HeavyLight) lower(v, w int) int {
+	h.validateVertex(v)
+	h.validateVertex(w)
+	if h.parent[v] == w {
+		return v
+	}
+	if h.parent[w] == v {
+		return w
+	}
+	panic(fmt.Sprintln("vertices ", v, " and ", w, " are not adjacent"))
+}
+
+func (h *HeavyLight) validateVertex(v int) {
+	V := len(h.parent)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
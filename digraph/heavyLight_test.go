@@ -0,0 +1,123 @@
+package digraph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns a random tree with integer weights, and the parent of every vertex when rooted at 0
+func randomWeightedTree(r *rand.Rand, V int) (*EdgeWeightedGraph, []int) {
+	G := NewEdgeWeightedGraphV(V)
+	parent := make([]int, V)
+	parent[0] = -1
+	for v := 1; v < V; v++ {
+		parent[v] = r.Intn(v)
+		G.AddEdge(NewEdge(v, parent[v], float64(r.Intn(20))))
+	}
+	return G, parent
+}
+
+// returns the vertices on the path from v to w in a tree given by its parents
+func treePath(parent []int, v, w int) []int {
+	onPath := make(map[int]int)
+	var up []int
+	for u := v; u >= 0; u = parent[u] {
+		onPath[u] = len(up)
+		up = append(up, u)
+	}
+	var down []int
+	u := w
+	for ; ; u = parent[u] {
+		if _, ok := onPath[u]; ok {
+			break
+		}
+		down = append(down, u)
+	}
+	path := up[:onPath[u]+1]
+	for i := len(down) - 1; i >= 0; i-- {
+		path = append(path, down[i])
+	}
+	return path
+}
+
+func TestHeavyLight(t *testing.T) {
+	assert := assert.New(t)
+	sum := func(a, b float64) float64 { return a + b }
+
+	// path 0-1-2-3 with a branch 1-4
+	G := NewEdgeWeightedGraphV(5)
+	G.AddEdge(NewEdge(0, 1, 1))
+	G.AddEdge(NewEdge(1, 2, 2))
+	G.AddEdge(NewEdge(2, 3, 4))
+	G.AddEdge(NewEdge(1, 4, 8))
+	hl := NewHeavyLight(G, 0, 0, sum)
+	assert.Equal(14.0, hl.Query(3, 4))
+	assert.Equal(0.0, hl.Query(2, 2))
+	assert.Equal(7.0, hl.Query(0, 3))
+	hl.Update(2, 1, 16)
+	assert.Equal(16.0, hl.Weight(1, 2))
+	assert.Equal(28.0, hl.Query(4, 3))
+	hl = NewHeavyLight(G, 3, math.Inf(-1), math.Max)
+	assert.Equal(8.0, hl.Query(0, 4))
+	assert.Equal(4.0, hl.Query(3, 1))
+
+	assert.Panics(func() { hl.Update(0, 2, 1) })
+	assert.Panics(func() { hl.Query(0, 5) })
+	G.AddEdge(NewEdge(3, 4, 1))
+	assert.Panics(func() { NewHeavyLight(G, 0, 0, sum) })
+	single := NewHeavyLight(NewEdgeWeightedGraphV(1), 0, 0, sum)
+	assert.Equal(0.0, single.Query(0, 0))
+}
+
+func TestHeavyLightRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(40))
+	// the first weight on the path that is not -1: associative but not commutative
+	first := func(a, b float64) float64 {
+		if a != -1 {
+			return a
+		}
+		return b
+	}
+	for trial := 0; trial < 10; trial++ {
+		V := 1 + r.Intn(300)
+		G, parent := randomWeightedTree(r, V)
+		root := r.Intn(V)
+		weight := make(map[[2]int]float64)
+		for _, e := range G.Edges() {
+			v := e.Either()
+			weight[[2]int{v, e.Other(v)}] = e.Weight()
+			weight[[2]int{e.Other(v), v}] = e.Weight()
+		}
+		sums := NewHeavyLight(G, root, 0, func(a, b float64) float64 { return a + b })
+		maxima := NewHeavyLight(G, root, math.Inf(-1), math.Max)
+		firsts := NewHeavyLight(G, root, -1, first)
+		for i := 0; i < 300; i++ {
+			v, w := r.Intn(V), r.Intn(V)
+			if i%3 == 0 && v > 0 {
+				x := float64(r.Intn(20))
+				weight[[2]int{v, parent[v]}] = x
+				weight[[2]int{parent[v], v}] = x
+				sums.Update(v, parent[v], x)
+				maxima.Update(parent[v], v, x)
+				firsts.Update(v, parent[v], x)
+				assert.Equal(x, sums.Weight(parent[v], v))
+				continue
+			}
+			path := treePath(parent, v, w)
+			s, m, f := 0.0, math.Inf(-1), -1.0
+			for j := 1; j < len(path); j++ {
+				x := weight[[2]int{path[j-1], path[j]}]
+				s += x
+				m = math.Max(m, x)
+				f = first(f, x)
+			}
+			assert.Equal(s, sums.Query(v, w))
+			assert.Equal(m, maxima.Query(v, w))
+			assert.Equal(f, firsts.Query(v, w))
+		}
+	}
+}
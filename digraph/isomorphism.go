@@ -0,0 +1,128 @@
+package digraph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/handane123/algorithms/internal/vf2"
+)
+
+// Isomorphism struct represents a data type for matching the vertices of a pattern digraph to those of a target
+// digraph. An isomorphism is a bijection between the vertices of two digraphs that preserves the direction of
+// every edge, with the same number of parallel edges and self-loops between corresponding vertices. A subgraph
+// isomorphism maps the pattern one-to-one onto some vertices of the target so that every edge of the pattern is
+// present in the target; an induced subgraph isomorphism also requires that no other edges join the matched
+// target vertices. An optional compatibility function restricts which target vertices each pattern vertex may
+// be matched to, for instance vertices with the same label (see LabelsByName).
+// This implementation uses the VF2 algorithm: it extends a partial matching one pattern vertex at a time,
+// visiting the pattern in breadth-first order (ignoring directions) from vertices of high degree, trying only
+// the target neighbors of an already matched neighbor, and pruning pairs whose degrees or numbers of matched,
+// frontier and unexplored neighbors cannot be reconciled. It takes exponential time in the worst case but is
+// fast on most digraphs. Each instance method takes O(1) time, except Mapping, which takes O(V) time.
+// It uses O(V + E) extra space (not including the digraphs).
+type Isomorphism struct {
+	mapping []int // mapping[v] = target vertex matched to pattern vertex v, nil if there is no match
+}
+
+// NewIsomorphism finds an isomorphism from the digraph G to the digraph H that matches only compatible
+// vertices; compatible may be nil to allow every pair.
+func NewIsomorphism(G, H *Digraph, compatible func(v, w int) bool) *Isomorphism {
+	if G.V() != H.V() || G.E() != H.E() {
+		return &Isomorphism{}
+	}
+	return newIsomorphism(G, H, compatible, vf2.Isomorphism)
+}
+
+// NewSubgraphIsomorphism finds a subgraph isomorphism, or an induced subgraph isomorphism if induced is true,
+// from the pattern digraph to the target digraph that matches only compatible vertices;
+// compatible may be nil to allow every pair.
+func NewSubgraphIsomorphism(pattern, target *Digraph, induced bool, compatible func(v, w int) bool) *Isomorphism {
+	if pattern.V() > target.V() || pattern.E() > target.E() {
+		return &Isomorphism{}
+	}
+	if induced {
+		return newIsomorphism(pattern, target, compatible, vf2.InducedSubgraph)
+	}
+	return newIsomorphism(pattern, target, compatible, vf2.Subgraph)
+}
+
+func newIsomorphism(pattern, target *Digraph, compatible func(v, w int) bool, kind vf2.Kind) *Isomorphism {
+	return &Isomorphism{mapping: vf2.Match(multiplicities(pattern), multiplicities(target), kind, compatible)}
+}
+
+// returns the adjacency of G for matching: the distinct vertices joined to every vertex by an edge in either
+// direction, in ascending order, the number of edges from every vertex to each of its out-neighbors, and the
+// outdegree and indegree of every vertex
+func multiplicities(G *Digraph) *vf2.Graph {
+	V := G.V()
+	neighbors := make([][]int, V)
+	count := make([]map[int]int, V)
+	outdegree := make([]int, V)
+	indegree := make([]int, V)
+	for v := 0; v < V; v++ {
+		count[v] = make(map[int]int)
+		outdegree[v] = G.OutDegree(v)
+		indegree[v] = G.InDegree(v)
+		for _, w := range G.Adj(v) {
+			count[v][w]++
+			if w != v {
+				neighbors[v] = append(neighbors[v], w)
+				neighbors[w] = append(neighbors[w], v)
+			}
+		}
+	}
+	for v := range neighbors {
+		sort.Ints(neighbors[v])
+		n := 0
+		for i, w := range neighbors[v] {
+			if i == 0 || w != neighbors[v][i-1] {
+				neighbors[v][n] = w
+				n++
+			}
+		}
+		neighbors[v] = neighbors[v][:n]
+	}
+	return &vf2.Graph{Neighbors: neighbors, Count: count, Outdegree: outdegree, Indegree: indegree}
+}
+
+// LabelsByName returns a compatibility function that matches vertex v of the symbol digraph G to vertex w of
+// the symbol digraph H if their names have the same label; a nil label compares the names themselves.
+func LabelsByName(G, H *SymbolDigraph, label func(name string) string) func(v, w int) bool {
+	if label == nil {
+		label = func(name string) string { return name }
+	}
+	return func(v, w int) bool {
+		return label(G.NameOf(v)) == label(H.NameOf(w))
+	}
+}
+
+// Found returns true if a matching was found.
+func (iso *Isomorphism) Found() bool {
+	return iso.mapping != nil
+}
+
+// Map returns the target vertex matched to the pattern vertex v.
+func (iso *Isomorphism) Map(v int) int {
+	if iso.mapping == nil {
+		panic("no matching was found")
+	}
+	iso.validateVertex(v)
+	return iso.mapping[v]
+}
+
+// Mapping returns the target vertex matched to every pattern vertex, or nil if no matching was found.
+func (iso *Isomorphism) Mapping() (mapping []int) {
+	if iso.mapping == nil {
+		return nil
+	}
+	mapping = make([]int, len(iso.mapping))
+	copy(mapping, iso.mapping)
+	return mapping
+}
+
+func (iso *Isomorphism) validateVertex(v int) {
+	V := len(iso.mapping)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
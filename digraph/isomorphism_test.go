@@ -0,0 +1,176 @@
+package digraph
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/handane123/algorithms/internal/vf2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsomorphism(t *testing.T) {
+	assert := assert.New(t)
+
+	// a directed 4-cycle and the same cycle with one edge reversed
+	cycle := NewDigraph(4)
+	flipped := NewDigraph(4)
+	shuffled := NewDigraph(4)
+	for i := 0; i < 4; i++ {
+		cycle.AddEdge(i, (i+1)%4)
+		shuffled.AddEdge((i+1)%4, i)
+	}
+	flipped.AddEdge(0, 1)
+	flipped.AddEdge(1, 2)
+	flipped.AddEdge(2, 3)
+	flipped.AddEdge(0, 3)
+	assert.False(NewIsomorphism(cycle, flipped, nil).Found())
+	iso := NewIsomorphism(cycle, shuffled, nil)
+	assert.True(iso.Found())
+	assertMapping(assert, cycle, shuffled, iso.Mapping(), vf2.Isomorphism)
+	assert.Panics(func() { iso.Map(4) })
+	assert.Panics(func() { NewIsomorphism(cycle, flipped, nil).Map(0) })
+
+	// direction of parallel edges must correspond
+	a := NewDigraph(2)
+	a.AddEdge(0, 1)
+	a.AddEdge(0, 1)
+	a.AddEdge(1, 0)
+	b := NewDigraph(2)
+	b.AddEdge(0, 1)
+	b.AddEdge(1, 0)
+	b.AddEdge(1, 0)
+	assert.Equal([]int{1, 0}, NewIsomorphism(a, b, nil).Mapping())
+
+	assert.True(NewIsomorphism(NewDigraph(0), NewDigraph(0), nil).Found())
+}
+
+func TestSubgraphIsomorphism(t *testing.T) {
+	assert := assert.New(t)
+
+	// a transitive tournament on 4 vertices contains the path 0->1->2 but not as an induced subgraph
+	tournament := NewDigraph(4)
+	for v := 0; v < 4; v++ {
+		for w := v + 1; w < 4; w++ {
+			tournament.AddEdge(v, w)
+		}
+	}
+	path := NewDigraph(3)
+	path.AddEdge(0, 1)
+	path.AddEdge(1, 2)
+	sub := NewSubgraphIsomorphism(path, tournament, false, nil)
+	assert.True(sub.Found())
+	assertMapping(assert, path, tournament, sub.Mapping(), vf2.Subgraph)
+	assert.False(NewSubgraphIsomorphism(path, tournament, true, nil).Found())
+
+	// a directed cycle is not contained in an acyclic digraph
+	triangle := NewDigraph(3)
+	triangle.AddEdge(0, 1)
+	triangle.AddEdge(1, 2)
+	triangle.AddEdge(2, 0)
+	assert.False(NewSubgraphIsomorphism(triangle, tournament, false, nil).Found())
+}
+
+func TestSubgraphIsomorphismRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(6))
+	for trial := 0; trial < 50; trial++ {
+		V := 30
+		target := NewDigraph(V)
+		for i := 0; i < 80; i++ {
+			target.AddEdge(r.Intn(V), r.Intn(V))
+		}
+
+		// the subdigraph induced by random vertices, renumbered
+		vertices := r.Perm(V)[:10]
+		index := make(map[int]int)
+		for i, v := range vertices {
+			index[v] = i
+		}
+		pattern := NewDigraph(len(vertices))
+		for _, v := range vertices {
+			for _, w := range target.Adj(v) {
+				if j, ok := index[w]; ok {
+					pattern.AddEdge(index[v], j)
+				}
+			}
+		}
+		induced := NewSubgraphIsomorphism(pattern, target, true, nil)
+		assert.True(induced.Found())
+		assertMapping(assert, pattern, target, induced.Mapping(), vf2.InducedSubgraph)
+		sub := NewSubgraphIsomorphism(pattern, target, false, nil)
+		assert.True(sub.Found())
+		assertMapping(assert, pattern, target, sub.Mapping(), vf2.Subgraph)
+
+		// a shuffled copy is isomorphic and has the same hash, the reverse usually is not
+		perm := r.Perm(V)
+		copied := NewDigraph(V)
+		for v := 0; v < V; v++ {
+			for _, w := range target.Adj(v) {
+				copied.AddEdge(perm[v], perm[w])
+			}
+		}
+		iso := NewIsomorphism(target, copied, nil)
+		assert.True(iso.Found())
+		assertMapping(assert, target, copied, iso.Mapping(), vf2.Isomorphism)
+		assert.Equal(WeisfeilerLehmanHash(target, 3, nil), WeisfeilerLehmanHash(copied, 3, nil))
+		if WeisfeilerLehmanHash(target, 3, nil) != WeisfeilerLehmanHash(target.Reverse(), 3, nil) {
+			assert.False(NewIsomorphism(target, target.Reverse(), nil).Found())
+		}
+	}
+}
+
+func TestLabelsByName(t *testing.T) {
+	assert := assert.New(t)
+	// a pipeline fetch->parse->store written with different instance numbers, and one with the steps swapped
+	pipeline := newTestSymbolDigraph(t, "fetch1 parse1\nparse1 store1\n")
+	renamed := newTestSymbolDigraph(t, "parse2 store2\nfetch2 parse2\n")
+	swapped := newTestSymbolDigraph(t, "parse1 fetch1\nfetch1 store1\n")
+	step := func(name string) string { return strings.TrimRight(name, "0123456789") }
+
+	iso := NewIsomorphism(pipeline.Digraph(), renamed.Digraph(), LabelsByName(pipeline, renamed, step))
+	assert.True(iso.Found())
+	assert.Equal("store2", renamed.NameOf(iso.Map(pipeline.IndexOf("store1"))))
+	assert.False(NewIsomorphism(pipeline.Digraph(), swapped.Digraph(), LabelsByName(pipeline, swapped, step)).Found())
+	assert.True(NewIsomorphism(pipeline.Digraph(), swapped.Digraph(), nil).Found())
+	assert.False(NewIsomorphism(pipeline.Digraph(), renamed.Digraph(), LabelsByName(pipeline, renamed, nil)).Found())
+}
+
+func newTestSymbolDigraph(t *testing.T, content string) *SymbolDigraph {
+	tmpfile, err := ioutil.TempFile("", "symbols.*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte(content)); err != nil {
+		tmpfile.Close()
+		t.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return NewSymbolDigraph(tmpfile.Name(), " ")
+}
+
+// checks that mapping is one-to-one and preserves the edge counts between every ordered pair of pattern vertices
+func assertMapping(assert *assert.Assertions, pattern, target *Digraph, mapping []int, kind vf2.Kind) {
+	countP := multiplicities(pattern).Count
+	countT := multiplicities(target).Count
+	used := make(map[int]bool)
+	for _, x := range mapping {
+		assert.False(used[x])
+		used[x] = true
+	}
+	for v := range mapping {
+		for w := range mapping {
+			p, t := countP[v][w], countT[mapping[v]][mapping[w]]
+			if kind == vf2.Subgraph {
+				assert.LessOrEqual(p, t)
+			} else {
+				assert.Equal(p, t)
+			}
+		}
+	}
+}
@@ -10,7 +10,8 @@ import "fmt"
 // two vertices have the same component identifier if and only if they are in the same strong component.
 // This implementation uses the Kosaraju-Sharir algorithm. The constructor takes O(V + E) time,
 // where V is the number of vertices and E is the number of edges. Each instance method takes O(1) time.
-// It uses O(V) extra space (not including the digraph).
+// It uses O(V + E) extra space (not including the digraph) for the reverse digraph, which it builds as a
+// CSRDigraph whatever the representation of the digraph, so it takes 4E + 8V bytes on a CSR digraph too.
 type KosarajuSharirSCC struct {
 	marked []bool
 	id     []int
@@ -18,13 +19,13 @@ type KosarajuSharirSCC struct {
 }
 
 // NewKosarajuSharirSCC computes the strong components of the digraph G.
-func NewKosarajuSharirSCC(G *Digraph) *KosarajuSharirSCC {
+func NewKosarajuSharirSCC(G DigraphView) *KosarajuSharirSCC {
 	scc := &KosarajuSharirSCC{
 		marked: make([]bool, G.V()),
 		id:     make([]int, G.V()),
 	}
 
-	dfo := NewDepthFirstOrder(G.Reverse())
+	dfo := NewDepthFirstOrder(reverse(G))
 	for _, v := range dfo.ReversePost() {
 		if !scc.marked[v] {
 			scc.dfs(G, v)
@@ -34,7 +35,7 @@ func NewKosarajuSharirSCC(G *Digraph) *KosarajuSharirSCC {
 	return scc
 }
 
-func (scc *KosarajuSharirSCC) dfs(G *Digraph, v int) {
+func (scc *KosarajuSharirSCC) dfs(G DigraphView, v int) {
 	scc.marked[v] = true
 	scc.id[v] = scc.count
 	for _, w := range G.Adj(v) {
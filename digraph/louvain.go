@@ -0,0 +1,250 @@
+package digraph
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// Louvain struct represents a data type for detecting communities in an edge-weighted graph
+// with nonnegative weights by maximizing modularity.
+// The modularity of a partition is the fraction of the total edge weight that falls inside communities,
+// minus the fraction expected if the edges were rewired at random keeping every vertex's weighted degree.
+// The communities are numbered 0 through Count() - 1 in order of their smallest vertex.
+// This implementation uses the Louvain method: vertices are visited in random order and greedily moved
+// to the neighboring community with the largest modularity gain until no move helps, then each community
+// is contracted to a single vertex and the process repeats on the contracted graph.
+// The random order is drawn from a generator seeded by the caller, so equal seeds give equal results.
+// Each level takes O(E) time per pass over the vertices; in practice few passes and levels are needed.
+// Each instance method takes O(1) time. It uses O(E + V) extra space (not including the graph).
+type Louvain struct {
+	community  []int   // community[v] = community of vertex v
+	count      int     // number of communities
+	modularity float64 // modularity of the partition
+}
+
+// a contracted graph: weighted adjacency lists without self-loops, plus the self-loop weight of each vertex
+type louvainGraph struct {
+	adj    [][]louvainEdge
+	self   []float64 // self[v] = weight of self-loops at v, counted at both ends
+	degree []float64 // degree[v] = weighted degree of v
+	total  float64   // sum of the weighted degrees (twice the total edge weight)
+}
+
+type louvainEdge struct {
+	to     int
+	weight float64
+}
+
+// NewLouvain computes a partition of the edge-weighted graph G into communities of high modularity,
+// visiting the vertices in an order drawn from a random generator with the given seed.
+func NewLouvain(G *EdgeWeightedGraph, seed int64) *Louvain {
+	g := &louvainGraph{
+		adj:    make([][]louvainEdge, G.V()),
+		self:   make([]float64, G.V()),
+		degree: make([]float64, G.V()),
+	}
+	for _, e := range G.Edges() {
+		if e.Weight() < 0 {
+			panic(fmt.Sprintln("edge ", e, " has negative weight"))
+		}
+		v := e.Either()
+		w := e.Other(v)
+		if v == w {
+			g.self[v] += 2 * e.Weight()
+		} else {
+			g.adj[v] = append(g.adj[v], louvainEdge{to: w, weight: e.Weight()})
+			g.adj[w] = append(g.adj[w], louvainEdge{to: v, weight: e.Weight()})
+		}
+	}
+	for v := range g.adj {
+		g.degree[v] = g.self[v]
+		for _, e := range g.adj[v] {
+			g.degree[v] += e.weight
+		}
+		g.total += g.degree[v]
+	}
+
+	lv := &Louvain{community: make([]int, G.V())}
+	for v := range lv.community {
+		lv.community[v] = v
+	}
+	r := rand.New(rand.NewSource(seed))
+	level := g
+	for {
+		moved, membership, n := level.moveVertices(r)
+		for v, c := range lv.community {
+			lv.community[v] = membership[c]
+		}
+		if !moved || n == len(level.adj) {
+			break
+		}
+		level = level.contract(membership, n)
+	}
+
+	lv.count = renumber(lv.community)
+	lv.modularity = g.modularity(lv.community, lv.count)
+	return lv
+}
+
+// moves vertices between communities until no move increases modularity; returns whether any vertex moved,
+// the community of every vertex (numbered 0 through n - 1) and the number of communities n
+func (g *louvainGraph) moveVertices(r *rand.Rand) (moved bool, membership []int, n int) {
+	V := len(g.adj)
+	membership = make([]int, V)
+	tot := make([]float64, V) // tot[c] = sum of the degrees of the vertices in community c
+	for v := range membership {
+		membership[v] = v
+		tot[v] = g.degree[v]
+	}
+	if g.total == 0 {
+		return false, membership, renumber(membership)
+	}
+
+	weightTo := make([]float64, V) // weightTo[c] = weight of edges from the current vertex to community c
+	listed := make([]bool, V)      // listed[c] = is c in neighbors?
+	var neighbors []int            // communities adjacent to the current vertex, in order of discovery
+	// ignore gains lost in rounding so that the passes terminate
+	eps := 1e-12 * g.total
+	for improved := true; improved; {
+		improved = false
+		for _, v := range r.Perm(V) {
+			cv := membership[v]
+			neighbors = append(neighbors[:0], cv)
+			listed[cv] = true
+			for _, e := range g.adj[v] {
+				c := membership[e.to]
+				if !listed[c] {
+					listed[c] = true
+					neighbors = append(neighbors, c)
+				}
+				weightTo[c] += e.weight
+			}
+
+			// remove v from its community, then insert it where the gain is largest
+			tot[cv] -= g.degree[v]
+			best := cv
+			bestGain := weightTo[cv] - tot[cv]*g.degree[v]/g.total
+			for _, c := range neighbors {
+				if gain := weightTo[c] - tot[c]*g.degree[v]/g.total; gain > bestGain+eps {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += g.degree[v]
+			membership[v] = best
+			if best != cv {
+				improved = true
+				moved = true
+			}
+			for _, c := range neighbors {
+				weightTo[c] = 0
+				listed[c] = false
+			}
+		}
+	}
+	return moved, membership, renumber(membership)
+}
+
+// returns the graph whose vertices are the n communities of membership
+func (g *louvainGraph) contract(membership []int, n int) *louvainGraph {
+	h := &louvainGraph{
+		adj:    make([][]louvainEdge, n),
+		self:   make([]float64, n),
+		degree: make([]float64, n),
+		total:  g.total,
+	}
+	weights := make([]map[int]float64, n)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+	for v := range g.adj {
+		cv := membership[v]
+		h.self[cv] += g.self[v]
+		h.degree[cv] += g.degree[v]
+		for _, e := range g.adj[v] {
+			if cw := membership[e.to]; cw == cv {
+				h.self[cv] += e.weight
+			} else {
+				weights[cv][cw] += e.weight
+			}
+		}
+	}
+	// sort the neighbors so that the contracted graph does not depend on map iteration order
+	for c := range weights {
+		for d, w := range weights[c] {
+			h.adj[c] = append(h.adj[c], louvainEdge{to: d, weight: w})
+		}
+		sort.Slice(h.adj[c], func(i, j int) bool { return h.adj[c][i].to < h.adj[c][j].to })
+	}
+	return h
+}
+
+// returns the modularity of the partition of g into count communities
+func (g *louvainGraph) modularity(community []int, count int) float64 {
+	if g.total == 0 {
+		return 0
+	}
+	inside := make([]float64, count)
+	tot := make([]float64, count)
+	for v := range g.adj {
+		c := community[v]
+		inside[c] += g.self[v]
+		tot[c] += g.degree[v]
+		for _, e := range g.adj[v] {
+			if community[e.to] == c {
+				inside[c] += e.weight
+			}
+		}
+	}
+	q := 0.0
+	for c := range inside {
+		q += inside[c]/g.total - (tot[c]/g.total)*(tot[c]/g.total)
+	}
+	return q
+}
+
+// renumbers the labels in order of first appearance and returns the number of distinct labels
+func renumber(labels []int) int {
+	id := make(map[int]int)
+	for v, l := range labels {
+		c, ok := id[l]
+		if !ok {
+			c = len(id)
+			id[l] = c
+		}
+		labels[v] = c
+	}
+	return len(id)
+}
+
+// Count returns the number of communities.
+func (lv *Louvain) Count() int {
+	return lv.count
+}
+
+// Community returns the id of the community containing vertex v.
+func (lv *Louvain) Community(v int) int {
+	lv.validateVertex(v)
+	return lv.community[v]
+}
+
+// Communities returns the vertices of every community, indexed by community id.
+func (lv *Louvain) Communities() (communities [][]int) {
+	communities = make([][]int, lv.count)
+	for v, c := range lv.community {
+		communities[c] = append(communities[c], v)
+	}
+	return communities
+}
+
+// Modularity returns the modularity of the partition into communities.
+func (lv *Louvain) Modularity() float64 {
+	return lv.modularity
+}
+
+func (lv *Louvain) validateVertex(v int) {
+	V := len(lv.community)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
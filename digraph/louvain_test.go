@@ -0,0 +1,58 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLouvain(t *testing.T) {
+	assert := assert.New(t)
+
+	// two triangles joined by the light edge 2-3, and the isolated vertex 6
+	G := NewEdgeWeightedGraphV(7)
+	G.AddEdge(NewEdge(0, 1, 1.0))
+	G.AddEdge(NewEdge(1, 2, 1.0))
+	G.AddEdge(NewEdge(0, 2, 1.0))
+	G.AddEdge(NewEdge(3, 4, 1.0))
+	G.AddEdge(NewEdge(4, 5, 1.0))
+	G.AddEdge(NewEdge(3, 5, 1.0))
+	G.AddEdge(NewEdge(2, 3, 1.0))
+
+	for seed := int64(0); seed < 10; seed++ {
+		lv := NewLouvain(G, seed)
+		assert.Equal(3, lv.Count())
+		assert.Equal([][]int{{0, 1, 2}, {3, 4, 5}, {6}}, lv.Communities())
+		assert.InEpsilon(2*(6.0/14-0.25), lv.Modularity(), 1e-12)
+	}
+
+	// a heavy bridge pulls its endpoints together
+	G.AddEdge(NewEdge(2, 3, 20.0))
+	lv := NewLouvain(G, 1)
+	assert.Equal(lv.Community(2), lv.Community(3))
+	assert.Equal(0, lv.Community(0))
+	assert.Panics(func() { lv.Community(7) })
+
+	// self-loops count as internal weight
+	H := NewEdgeWeightedGraphV(2)
+	H.AddEdge(NewEdge(0, 0, 1.0))
+	H.AddEdge(NewEdge(1, 1, 1.0))
+	lv = NewLouvain(H, 0)
+	assert.Equal(2, lv.Count())
+	assert.InEpsilon(0.5, lv.Modularity(), 1e-12)
+
+	assert.Equal(0.0, NewLouvain(NewEdgeWeightedGraphV(3), 0).Modularity())
+	H.AddEdge(NewEdge(0, 1, -1.0))
+	assert.Panics(func() { NewLouvain(H, 0) })
+}
+
+func TestLouvain_Seed(t *testing.T) {
+	assert := assert.New(t)
+
+	G := NewEdgeWeightedGraphVE(60, 200)
+	a := NewLouvain(G, 42)
+	b := NewLouvain(G, 42)
+	assert.Equal(a.Communities(), b.Communities())
+	assert.Equal(a.Modularity(), b.Modularity())
+	assert.Greater(a.Modularity(), 0.0)
+}
@@ -0,0 +1,232 @@
+package digraph
+
+import "fmt"
+
+// Metrics struct represents a data type for computing the distance-based metrics of a digraph:
+// the eccentricity of every vertex, the diameter, radius, center and periphery, and the girth.
+// The eccentricity of v is the largest length of a shortest directed path from v to a vertex reachable from v,
+// so a vertex without outgoing edges has eccentricity 0 and a digraph that is not strongly connected gets
+// finite metrics. The girth is the length of a shortest directed cycle, where a self-loop is a cycle of length 1.
+// This implementation computes the eccentricities with the bounding algorithm of Takes and Kosters adapted
+// to digraphs: a forward and a backward breadth-first search from v bound the eccentricities of the vertices
+// in the strong component of v, which all reach the same vertices, and the searches alternate between
+// the vertex with the largest upper bound and the one with the smallest lower bound until every bound is exact.
+// The girth is computed by a breadth-first search from every vertex, cut off at the shortest cycle found so far.
+// The constructor takes O(V E) time in the worst case, where V is the number of vertices and E is the number
+// of edges, but needs far fewer than V searches on digraphs with large strong components.
+// Each instance method takes O(1) time, except Center and Periphery, which take O(V) time.
+// It uses O(V + E) extra space (not including the digraph).
+type Metrics struct {
+	eccentricity []int // eccentricity[v] = eccentricity of v
+	diameter     int
+	radius       int
+	girth        int // length of a shortest directed cycle, -1 if acyclic
+	searches     int // number of forward breadth-first searches used for the eccentricities
+}
+
+// NewMetrics computes the distance-based metrics of the digraph G.
+func NewMetrics(G *Digraph) *Metrics {
+	V := G.V()
+	adj := make([][]int, V)
+	reverse := make([][]int, V)
+	for v := 0; v < V; v++ {
+		adj[v] = G.Adj(v)
+		for _, w := range adj[v] {
+			reverse[w] = append(reverse[w], v)
+		}
+	}
+	m := &Metrics{eccentricity: make([]int, V)}
+	m.eccentricities(adj, reverse)
+	for v, e := range m.eccentricity {
+		if e > m.diameter {
+			m.diameter = e
+		}
+		if v == 0 || e < m.radius {
+			m.radius = e
+		}
+	}
+	m.girth = girth(adj)
+	return m
+}
+
+// computes every eccentricity by tightening lower and upper bounds
+func (m *Metrics) eccentricities(adj, reverse [][]int) {
+	V := len(adj)
+	lower := make([]int, V)
+	upper := make([]int, V)
+	done := make([]bool, V)
+	from := make([]int, V) // from[w] = dist(v, w) for the current vertex v, -1 if unreachable
+	to := make([]int, V)   // to[w] = dist(w, v) for the current vertex v, -1 if unreachable
+	for v := 0; v < V; v++ {
+		upper[v] = infinity
+		from[v] = -1
+		to[v] = -1
+	}
+	var forward, backward []int
+	remaining := V
+	for high := true; remaining > 0; high = !high {
+		// pick the undecided vertex of largest upper or smallest lower bound, breaking ties by outdegree
+		v := -1
+		for w := 0; w < V; w++ {
+			if done[w] {
+				continue
+			}
+			switch {
+			case v < 0:
+				v = w
+			case high && (upper[w] > upper[v] || upper[w] == upper[v] && len(adj[w]) > len(adj[v])):
+				v = w
+			case !high && (lower[w] < lower[v] || lower[w] == lower[v] && len(adj[w]) > len(adj[v])):
+				v = w
+			}
+		}
+
+		forward = bfs(adj, v, from, forward)
+		backward = bfs(reverse, v, to, backward)
+		m.searches++
+		e := from[forward[len(forward)-1]]
+		m.eccentricity[v] = e
+		done[v] = true
+		remaining--
+		// every w reaching v has ecc(w) >= dist(w, v); if v also reaches w, they are strongly connected
+		// and max(e - dist(v, w), dist(w, v)) <= ecc(w) <= dist(w, v) + e
+		for _, w := range backward {
+			if done[w] {
+				continue
+			}
+			if to[w] > lower[w] {
+				lower[w] = to[w]
+			}
+			if from[w] >= 0 {
+				if e-from[w] > lower[w] {
+					lower[w] = e - from[w]
+				}
+				if to[w]+e < upper[w] {
+					upper[w] = to[w] + e
+				}
+			}
+			if lower[w] == upper[w] {
+				m.eccentricity[w] = lower[w]
+				done[w] = true
+				remaining--
+			}
+		}
+		for _, w := range forward {
+			from[w] = -1
+		}
+		for _, w := range backward {
+			to[w] = -1
+		}
+	}
+}
+
+// breadth-first search from s; sets dist of the reached vertices, which must be -1 beforehand,
+// and returns them in order of distance, reusing the order slice
+func bfs(adj [][]int, s int, dist []int, order []int) []int {
+	order = append(order[:0], s)
+	dist[s] = 0
+	// order doubles as the queue
+	for head := 0; head < len(order); head++ {
+		v := order[head]
+		for _, w := range adj[v] {
+			if dist[w] < 0 {
+				dist[w] = dist[v] + 1
+				order = append(order, w)
+			}
+		}
+	}
+	return order
+}
+
+// returns the length of a shortest directed cycle, -1 if there is none
+func girth(adj [][]int) int {
+	V := len(adj)
+	best := infinity
+	dist := make([]int, V)
+	for v := range dist {
+		dist[v] = -1
+	}
+	var order []int
+	for s := 0; s < V; s++ {
+		order = append(order[:0], s)
+		dist[s] = 0
+		for head := 0; head < len(order); head++ {
+			u := order[head]
+			// no cycle through s found from here on is shorter than dist[u] + 1
+			if dist[u]+1 >= best {
+				break
+			}
+			for _, w := range adj[u] {
+				if w == s {
+					best = dist[u] + 1
+					break
+				}
+				if dist[w] < 0 {
+					dist[w] = dist[u] + 1
+					order = append(order, w)
+				}
+			}
+		}
+		for _, v := range order {
+			dist[v] = -1
+		}
+	}
+	if best == infinity {
+		return -1
+	}
+	return best
+}
+
+// Eccentricity returns the eccentricity of vertex v.
+func (m *Metrics) Eccentricity(v int) int {
+	m.validateVertex(v)
+	return m.eccentricity[v]
+}
+
+// Diameter returns the largest eccentricity of any vertex, 0 for a digraph without vertices.
+func (m *Metrics) Diameter() int {
+	return m.diameter
+}
+
+// Radius returns the smallest eccentricity of any vertex, 0 for a digraph without vertices.
+func (m *Metrics) Radius() int {
+	return m.radius
+}
+
+// Center returns the vertices whose eccentricity equals the radius, in ascending order.
+func (m *Metrics) Center() (vertices []int) {
+	for v, e := range m.eccentricity {
+		if e == m.radius {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// Periphery returns the vertices whose eccentricity equals the diameter, in ascending order.
+func (m *Metrics) Periphery() (vertices []int) {
+	for v, e := range m.eccentricity {
+		if e == m.diameter {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// Girth returns the length of a shortest directed cycle, or -1 if the digraph is acyclic.
+func (m *Metrics) Girth() int {
+	return m.girth
+}
+
+// Searches returns the number of vertices from which breadth-first searches were run
+// to compute the eccentricities.
+func (m *Metrics) Searches() int {
+	return m.searches
+}
+
+func (m *Metrics) validateVertex(v int) {
+	V := len(m.eccentricity)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
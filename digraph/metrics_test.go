@@ -0,0 +1,75 @@
+package digraph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	// the directed cycle 0->1->2->3->0 with the chord 0->2, the tail 3->4->5 and the isolated vertex 6
+	g := NewDigraph(7)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}, {3, 4}, {4, 5}} {
+		g.AddEdge(e[0], e[1])
+	}
+	m := NewMetrics(g)
+	expected := []int{4, 4, 3, 2, 1, 0, 0}
+	for v, e := range expected {
+		assert.Equal(e, m.Eccentricity(v))
+	}
+	assert.Equal(4, m.Diameter())
+	assert.Equal(0, m.Radius())
+	assert.Equal([]int{5, 6}, m.Center())
+	assert.Equal([]int{0, 1}, m.Periphery())
+	assert.Equal(3, m.Girth())
+	assert.Panics(func() { m.Eccentricity(7) })
+
+	dag := NewDigraph(3)
+	dag.AddEdge(0, 1)
+	dag.AddEdge(0, 2)
+	dag.AddEdge(1, 2)
+	assert.Equal(-1, NewMetrics(dag).Girth())
+	dag.AddEdge(2, 1)
+	assert.Equal(2, NewMetrics(dag).Girth())
+	dag.AddEdge(0, 0)
+	assert.Equal(1, NewMetrics(dag).Girth())
+
+	empty := NewMetrics(NewDigraph(0))
+	assert.Equal(0, empty.Diameter())
+	assert.Equal(-1, empty.Girth())
+}
+
+func TestMetricsRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(4))
+	for trial := 0; trial < 10; trial++ {
+		V := 150
+		g := NewDigraph(V)
+		for i := 0; i < 400; i++ {
+			g.AddEdge(r.Intn(V), r.Intn(V))
+		}
+		m := NewMetrics(g)
+		girth := -1
+		for v := 0; v < V; v++ {
+			bfp := NewBreadthFirstDirectedPaths(g, v)
+			e := 0
+			for w := 0; w < V; w++ {
+				if bfp.HasPathTo(w) && bfp.DistTo(w) > e {
+					e = bfp.DistTo(w)
+				}
+				// a shortest cycle through v closes with an edge w->v
+				for _, x := range g.Adj(w) {
+					if x == v && bfp.HasPathTo(w) && (girth < 0 || bfp.DistTo(w)+1 < girth) {
+						girth = bfp.DistTo(w) + 1
+					}
+				}
+			}
+			assert.Equal(e, m.Eccentricity(v))
+		}
+		assert.Equal(girth, m.Girth())
+		assert.Less(m.Searches(), V)
+	}
+}
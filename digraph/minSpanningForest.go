@@ -0,0 +1,147 @@
+package digraph
+
+import (
+	"fmt"
+
+	"github.com/handane123/algorithms/fundamentals/unionfind"
+)
+
+// MinSpanningForest struct represents a data type for computing a minimum spanning forest
+// in an edge-weighted graph, broken down by connected component.
+// A minimum spanning forest is the union of the minimum spanning trees of the connected components.
+// The component identifiers are 0 through Count() - 1, numbered in order of the smallest vertex in each component.
+// This implementation uses Kruskal's algorithm (see KruskalMST) and the union-find data type.
+// The constructor takes O(E log E) time in the worst case. The Id, Size and TreeWeight methods take O(1) time;
+// the Tree and Vertices methods take time proportional to the size of the component.
+// It uses O(E + V) extra space (not including the graph).
+type MinSpanningForest struct {
+	weight   float64     // total weight of the forest
+	id       []int       // id[v] = id of the component containing v
+	size     []int       // size[id] = number of vertices in the component
+	trees    [][]*Edge   // trees[id] = edges of the minimum spanning tree of the component
+	weights  []float64   // weights[id] = weight of the minimum spanning tree of the component
+	vertices [][]int     // vertices[id] = vertices in the component, in ascending order
+	mst      *KruskalMST // the underlying minimum spanning forest
+}
+
+// NewMinSpanningForest computes a minimum spanning forest of the edge-weighted graph G.
+func NewMinSpanningForest(G *EdgeWeightedGraph) *MinSpanningForest {
+	msf := &MinSpanningForest{
+		id:  make([]int, G.V()),
+		mst: NewKruskalMST(G),
+	}
+	msf.weight = msf.mst.Weight()
+
+	uf := unionfind.NewUF(G.V())
+	for _, e := range msf.mst.Edges() {
+		v := e.Either()
+		uf.Union(v, e.Other(v))
+	}
+
+	// number the components in order of their smallest vertex
+	count := 0
+	root := make(map[int]int)
+	for v := 0; v < G.V(); v++ {
+		r := uf.Find(v)
+		c, ok := root[r]
+		if !ok {
+			c = count
+			root[r] = c
+			count++
+			msf.size = append(msf.size, 0)
+			msf.vertices = append(msf.vertices, nil)
+		}
+		msf.id[v] = c
+		msf.size[c]++
+		msf.vertices[c] = append(msf.vertices[c], v)
+	}
+
+	msf.trees = make([][]*Edge, count)
+	msf.weights = make([]float64, count)
+	for _, e := range msf.mst.Edges() {
+		c := msf.id[e.Either()]
+		msf.trees[c] = append(msf.trees[c], e)
+		msf.weights[c] += e.Weight()
+	}
+	return msf
+}
+
+// Count returns the number of trees in the forest (the number of connected components).
+func (msf *MinSpanningForest) Count() int {
+	return len(msf.trees)
+}
+
+// Id returns the id of the tree containing vertex v.
+func (msf *MinSpanningForest) Id(v int) int {
+	msf.validateVertex(v)
+	return msf.id[v]
+}
+
+// Size returns the number of vertices in the tree containing vertex v.
+func (msf *MinSpanningForest) Size(v int) int {
+	msf.validateVertex(v)
+	return msf.size[msf.id[v]]
+}
+
+// Connected returns true if vertices v and w are in the same tree of the forest.
+func (msf *MinSpanningForest) Connected(v, w int) bool {
+	msf.validateVertex(v)
+	msf.validateVertex(w)
+	return msf.id[v] == msf.id[w]
+}
+
+// Tree returns the edges of the minimum spanning tree of component id, in ascending order of weight.
+// An isolated vertex forms a tree with no edges.
+func (msf *MinSpanningForest) Tree(id int) (edges []*Edge) {
+	msf.validateComponent(id)
+	edges = make([]*Edge, len(msf.trees[id]))
+	copy(edges, msf.trees[id])
+	return edges
+}
+
+// Trees returns the edges of every tree in the forest, indexed by component id.
+func (msf *MinSpanningForest) Trees() (trees [][]*Edge) {
+	trees = make([][]*Edge, len(msf.trees))
+	for id := range msf.trees {
+		trees[id] = msf.Tree(id)
+	}
+	return trees
+}
+
+// Vertices returns the vertices of component id, in ascending order.
+func (msf *MinSpanningForest) Vertices(id int) (vertices []int) {
+	msf.validateComponent(id)
+	vertices = make([]int, len(msf.vertices[id]))
+	copy(vertices, msf.vertices[id])
+	return vertices
+}
+
+// TreeWeight returns the sum of the edge weights in the minimum spanning tree of component id.
+func (msf *MinSpanningForest) TreeWeight(id int) float64 {
+	msf.validateComponent(id)
+	return msf.weights[id]
+}
+
+// Edges returns all edges in the minimum spanning forest.
+func (msf *MinSpanningForest) Edges() []*Edge {
+	return msf.mst.Edges()
+}
+
+// Weight returns the sum of the edge weights in the minimum spanning forest.
+func (msf *MinSpanningForest) Weight() float64 {
+	return msf.weight
+}
+
+func (msf *MinSpanningForest) validateVertex(v int) {
+	V := len(msf.id)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
+
+func (msf *MinSpanningForest) validateComponent(id int) {
+	n := len(msf.trees)
+	if id < 0 || id >= n {
+		panic(fmt.Sprintln("component ", id, " is not between 0 and ", n-1))
+	}
+}
@@ -0,0 +1,60 @@
+package digraph
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/handane123/algorithms/io/stdin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinSpanningForest(t *testing.T) {
+	assert := assert.New(t)
+
+	// two components {0, 1, 2, 5} and {3, 4}, and the isolated vertex 6
+	ewg := "7\n" +
+		"6\n" +
+		"0 1 0.50\n" +
+		"1 2 0.25\n" +
+		"0 2 0.75\n" +
+		"2 5 1.00\n" +
+		"3 4 0.10\n" +
+		"4 3 0.20\n"
+
+	buf := strings.NewReader(ewg)
+	scanner := bufio.NewScanner(buf)
+	scanner.Split(bufio.ScanWords)
+	in := &stdin.In{Scanner: scanner}
+	G := NewEdgeWeightedGraphIn(in)
+
+	msf := NewMinSpanningForest(G)
+	assert.Equal(3, msf.Count())
+	assert.Equal(0, msf.Id(5))
+	assert.Equal(1, msf.Id(3))
+	assert.Equal(2, msf.Id(6))
+	assert.Equal(4, msf.Size(1))
+	assert.Equal(1, msf.Size(6))
+	assert.True(msf.Connected(0, 5))
+	assert.False(msf.Connected(0, 3))
+
+	assert.Equal([]*Edge{
+		NewEdge(1, 2, 0.25),
+		NewEdge(0, 1, 0.50),
+		NewEdge(2, 5, 1.00),
+	}, msf.Tree(0))
+	assert.Equal([]*Edge{NewEdge(3, 4, 0.10)}, msf.Tree(1))
+	assert.Empty(msf.Tree(2))
+	assert.Len(msf.Trees(), 3)
+
+	assert.Equal([]int{0, 1, 2, 5}, msf.Vertices(0))
+	assert.Equal([]int{6}, msf.Vertices(2))
+	assert.InEpsilon(1.75, msf.TreeWeight(0), 1e-9)
+	assert.InEpsilon(0.10, msf.TreeWeight(1), 1e-9)
+	assert.Equal(0.0, msf.TreeWeight(2))
+	assert.InEpsilon(1.85, msf.Weight(), 1e-9)
+	assert.Len(msf.Edges(), 4)
+
+	assert.Panics(func() { msf.Id(7) })
+	assert.Panics(func() { msf.Tree(3) })
+}
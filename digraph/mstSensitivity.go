@@ -0,0 +1,218 @@
+package digraph
+
+import (
+	"math"
+
+	"github.com/handane123/algorithms/dataStructure/priorityqueue"
+	"github.com/handane123/algorithms/dataStructure/queue/arrayqueue"
+)
+
+// MSTSensitivity struct represents a data type for answering sensitivity queries about a
+// minimum spanning forest of an edge-weighted graph: which edge takes over when an edge is removed
+// or its weight changes, and the range of weights over which the current forest stays minimum.
+// For a tree edge e, the replacement is the lightest non-tree edge that reconnects the two sides of e,
+// and the forest stays minimum while the weight of e is at most the weight of the replacement.
+// For a non-tree edge e, the replacement is the heaviest tree edge on the cycle that e closes,
+// and the forest stays minimum while the weight of e is at least the weight of the replacement.
+// This implementation roots each tree of a MinSpanningForest, walks the tree path of each non-tree edge
+// to find its heaviest edge, and scans the non-tree edges in ascending order of weight, skipping tree
+// edges that are already covered, to find the replacement of every tree edge.
+// The constructor takes O(E V) time in the worst case. Each instance method takes O(1) time.
+// It uses O(E + V) extra space (not including the graph).
+type MSTSensitivity struct {
+	msf         *MinSpanningForest
+	inTree      map[*Edge]bool  // inTree[e] = is e in the minimum spanning forest?
+	replacement map[*Edge]*Edge // replacement[e] = edge exchanged with e, nil if none
+	parent      []int           // parent[v] = parent of v in its rooted tree
+	parentEdge  []*Edge         // parentEdge[v] = tree edge from v to its parent
+	depth       []int           // depth[v] = number of edges from v to the root of its tree
+	jump        []int           // jump[v] = nearest ancestor of v whose parent edge is not yet covered
+}
+
+// NewMSTSensitivity computes the sensitivity of a minimum spanning forest of the edge-weighted graph G.
+func NewMSTSensitivity(G *EdgeWeightedGraph) *MSTSensitivity {
+	s := &MSTSensitivity{
+		msf:         NewMinSpanningForest(G),
+		inTree:      make(map[*Edge]bool),
+		replacement: make(map[*Edge]*Edge),
+		parent:      make([]int, G.V()),
+		parentEdge:  make([]*Edge, G.V()),
+		depth:       make([]int, G.V()),
+		jump:        make([]int, G.V()),
+	}
+	for _, e := range s.msf.Edges() {
+		s.inTree[e] = true
+	}
+	s.root(G)
+
+	pq := priorityqueue.NewMinPQ()
+	for _, e := range G.Edges() {
+		if s.inTree[e] {
+			continue
+		}
+		s.inTree[e] = false
+		v := e.Either()
+		if w := e.Other(v); v != w {
+			s.replacement[e] = s.heaviest(v, w)
+			pq.Insert(e)
+		}
+	}
+
+	// the lightest non-tree edge covering a tree edge is its replacement
+	for !pq.IsEmpty() {
+		k, _ := pq.DelMin()
+		e := k.(*Edge)
+		v := e.Either()
+		a, b := s.find(v), s.find(e.Other(v))
+		for a != b {
+			if s.depth[a] < s.depth[b] {
+				a, b = b, a
+			}
+			s.replacement[s.parentEdge[a]] = e
+			s.jump[a] = s.parent[a]
+			a = s.find(a)
+		}
+	}
+	return s
+}
+
+// root every tree of the forest at its smallest vertex using breadth-first search
+func (s *MSTSensitivity) root(G *EdgeWeightedGraph) {
+	adj := make([][]*Edge, G.V())
+	for _, e := range s.msf.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		adj[v] = append(adj[v], e)
+		adj[w] = append(adj[w], e)
+	}
+	marked := make([]bool, G.V())
+	for r := 0; r < G.V(); r++ {
+		if marked[r] {
+			continue
+		}
+		marked[r] = true
+		s.parent[r] = r
+		q := arrayqueue.New()
+		q.Enqueue(r)
+		for !q.IsEmpty() {
+			val, _ := q.Dequeue()
+			v := val.(int)
+			s.jump[v] = v
+			for _, e := range adj[v] {
+				w := e.Other(v)
+				if !marked[w] {
+					marked[w] = true
+					s.parent[w] = v
+					s.parentEdge[w] = e
+					s.depth[w] = s.depth[v] + 1
+					q.Enqueue(w)
+				}
+			}
+		}
+	}
+}
+
+// returns the heaviest edge on the tree path between v and w
+func (s *MSTSensitivity) heaviest(v, w int) *Edge {
+	var max *Edge
+	for v != w {
+		if s.depth[v] < s.depth[w] {
+			v, w = w, v
+		}
+		if e := s.parentEdge[v]; max == nil || e.Weight() > max.Weight() {
+			max = e
+		}
+		v = s.parent[v]
+	}
+	return max
+}
+
+// returns the nearest ancestor of v whose parent edge has no replacement yet
+func (s *MSTSensitivity) find(v int) int {
+	for v != s.jump[v] {
+		s.jump[v] = s.jump[s.jump[v]]
+		v = s.jump[v]
+	}
+	return v
+}
+
+// Forest returns the minimum spanning forest the queries refer to.
+func (s *MSTSensitivity) Forest() *MinSpanningForest {
+	return s.msf
+}
+
+// InTree returns true if edge e is in the minimum spanning forest.
+func (s *MSTSensitivity) InTree(e *Edge) bool {
+	s.validateEdge(e)
+	return s.inTree[e]
+}
+
+// Replacement returns the edge that is exchanged with edge e when e leaves (tree edge) or
+// enters (non-tree edge) the minimum spanning forest, or nil if there is no such edge:
+// e is a bridge, or e is a self-loop.
+func (s *MSTSensitivity) Replacement(e *Edge) *Edge {
+	s.validateEdge(e)
+	return s.replacement[e]
+}
+
+// Range returns the closed interval of weights for edge e over which the current
+// minimum spanning forest stays minimum. For a tree edge the interval is (-Inf, hi],
+// for a non-tree edge it is [lo, +Inf). A self-loop is never in a spanning forest,
+// so its interval is reported as [+Inf, +Inf].
+func (s *MSTSensitivity) Range(e *Edge) (lo, hi float64) {
+	s.validateEdge(e)
+	r := s.replacement[e]
+	if s.inTree[e] {
+		if r == nil {
+			return math.Inf(-1), math.Inf(1)
+		}
+		return math.Inf(-1), r.Weight()
+	}
+	if r == nil {
+		return math.Inf(1), math.Inf(1)
+	}
+	return r.Weight(), math.Inf(1)
+}
+
+// WeightWithout returns the weight of a minimum spanning forest of the graph with edge e removed.
+// Removing a bridge splits a tree, so the result is the weight of the remaining forest.
+func (s *MSTSensitivity) WeightWithout(e *Edge) float64 {
+	s.validateEdge(e)
+	if !s.inTree[e] {
+		return s.msf.Weight()
+	}
+	weight := s.msf.Weight() - e.Weight()
+	if r := s.replacement[e]; r != nil {
+		weight += r.Weight()
+	}
+	return weight
+}
+
+// WeightWith returns the weight of a minimum spanning forest of the graph after the weight of edge e
+// is changed to the given weight.
+func (s *MSTSensitivity) WeightWith(e *Edge, weight float64) float64 {
+	s.validateEdge(e)
+	if math.IsNaN(weight) {
+		panic("weight is NaN")
+	}
+	r := s.replacement[e]
+	if s.inTree[e] {
+		if r != nil && weight > r.Weight() {
+			return s.msf.Weight() - e.Weight() + r.Weight()
+		}
+		return s.msf.Weight() - e.Weight() + weight
+	}
+	if r != nil && weight < r.Weight() {
+		return s.msf.Weight() - r.Weight() + weight
+	}
+	return s.msf.Weight()
+}
+
+func (s *MSTSensitivity) validateEdge(e *Edge) {
+	if e == nil {
+		panic("argument is nil")
+	}
+	if _, ok := s.inTree[e]; !ok {
+		panic("edge " + e.String() + " is not in the graph")
+	}
+}
@@ -0,0 +1,127 @@
+package digraph
+
+import (
+	"bufio"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/handane123/algorithms/io/stdin"
+	"github.com/stretchr/testify/assert"
+)
+
+func findEdge(G *EdgeWeightedGraph, v, w int) *Edge {
+	for _, e := range G.Adj(v) {
+		if e.Other(v) == w {
+			return e
+		}
+	}
+	return nil
+}
+
+func TestMSTSensitivity(t *testing.T) {
+	assert := assert.New(t)
+
+	tinyEWG := "8\n" +
+		"16\n" +
+		"4 5 0.35\n" +
+		"4 7 0.37\n" +
+		"5 7 0.28\n" +
+		"0 7 0.16\n" +
+		"1 5 0.32\n" +
+		"0 4 0.38\n" +
+		"2 3 0.17\n" +
+		"1 7 0.19\n" +
+		"0 2 0.26\n" +
+		"1 2 0.36\n" +
+		"1 3 0.29\n" +
+		"2 7 0.34\n" +
+		"6 2 0.40\n" +
+		"3 6 0.52\n" +
+		"6 0 0.58\n" +
+		"6 4 0.93\n"
+
+	buf := strings.NewReader(tinyEWG)
+	scanner := bufio.NewScanner(buf)
+	scanner.Split(bufio.ScanWords)
+	in := &stdin.In{Scanner: scanner}
+	G := NewEdgeWeightedGraphIn(in)
+
+	s := NewMSTSensitivity(G)
+	assert.InEpsilon(1.81, s.Forest().Weight(), 1e-9)
+
+	// tree edges
+	replacements := [][4]int{
+		{0, 7, 1, 3},
+		{2, 3, 1, 3},
+		{1, 7, 1, 3},
+		{0, 2, 1, 3},
+		{5, 7, 1, 5},
+		{4, 5, 4, 7},
+		{6, 2, 3, 6},
+	}
+	for _, r := range replacements {
+		e := findEdge(G, r[0], r[1])
+		assert.True(s.InTree(e))
+		assert.Equal(findEdge(G, r[2], r[3]), s.Replacement(e))
+	}
+	lo, hi := s.Range(findEdge(G, 4, 5))
+	assert.Equal(math.Inf(-1), lo)
+	assert.Equal(0.37, hi)
+	assert.InEpsilon(1.83, s.WeightWithout(findEdge(G, 4, 5)), 1e-9)
+	assert.InEpsilon(1.76, s.WeightWith(findEdge(G, 4, 5), 0.30), 1e-9)
+	assert.InEpsilon(1.83, s.WeightWith(findEdge(G, 4, 5), 0.90), 1e-9)
+
+	// non-tree edges
+	heaviest := [][4]int{
+		{4, 7, 4, 5},
+		{1, 5, 5, 7},
+		{0, 4, 4, 5},
+		{1, 2, 0, 2},
+		{1, 3, 0, 2},
+		{2, 7, 0, 2},
+		{3, 6, 6, 2},
+		{6, 0, 6, 2},
+		{6, 4, 6, 2},
+	}
+	for _, h := range heaviest {
+		e := findEdge(G, h[0], h[1])
+		assert.False(s.InTree(e))
+		assert.Equal(findEdge(G, h[2], h[3]), s.Replacement(e))
+	}
+	lo, hi = s.Range(findEdge(G, 6, 4))
+	assert.Equal(0.40, lo)
+	assert.Equal(math.Inf(1), hi)
+	assert.InEpsilon(1.81, s.WeightWithout(findEdge(G, 6, 4)), 1e-9)
+	assert.InEpsilon(1.81, s.WeightWith(findEdge(G, 6, 4), 0.50), 1e-9)
+	assert.InEpsilon(1.51, s.WeightWith(findEdge(G, 6, 4), 0.10), 1e-9)
+
+	assert.Panics(func() { s.InTree(NewEdge(0, 7, 0.16)) })
+	assert.Panics(func() { s.Range(nil) })
+	assert.Panics(func() { s.WeightWith(findEdge(G, 0, 7), math.NaN()) })
+}
+
+func TestMSTSensitivity_Bridge(t *testing.T) {
+	assert := assert.New(t)
+
+	G := NewEdgeWeightedGraphV(3)
+	bridge := NewEdge(0, 1, 2.0)
+	loop := NewEdge(2, 2, 1.0)
+	G.AddEdge(bridge)
+	G.AddEdge(loop)
+
+	s := NewMSTSensitivity(G)
+	assert.True(s.InTree(bridge))
+	assert.Nil(s.Replacement(bridge))
+	lo, hi := s.Range(bridge)
+	assert.Equal(math.Inf(-1), lo)
+	assert.Equal(math.Inf(1), hi)
+	assert.Equal(0.0, s.WeightWithout(bridge))
+	assert.Equal(5.0, s.WeightWith(bridge, 5.0))
+
+	assert.False(s.InTree(loop))
+	assert.Nil(s.Replacement(loop))
+	lo, hi = s.Range(loop)
+	assert.Equal(math.Inf(1), lo)
+	assert.Equal(math.Inf(1), hi)
+}
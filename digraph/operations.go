@@ -0,0 +1,145 @@
+package digraph
+
+import "fmt"
+
+// The operations below build a new digraph from one or two digraphs, leaving them unchanged.
+// Parallel edges count with their multiplicity: the edge union of G and H has every edge v->w
+// as many times as the larger of its multiplicities in G and H, and their edge intersection as many
+// times as the smaller. Each operation takes time proportional to the size of its result, plus O(E + V)
+// for the digraphs it reads.
+
+// DisjointUnion returns the disjoint union of G and H: the vertices and edges of G, followed by those of H,
+// with vertex v of H numbered G.V() + v.
+func DisjointUnion(G, H *Digraph) *Digraph {
+	U := NewDigraph(G.V() + H.V())
+	for _, e := range G.edges() {
+		U.AddEdge(e[0], e[1])
+	}
+	for _, e := range H.edges() {
+		U.AddEdge(G.V()+e[0], G.V()+e[1])
+	}
+	return U
+}
+
+// EdgeUnion returns the digraph on the vertices of G and H with the edges of either.
+// It panics if G and H do not have the same number of vertices.
+func EdgeUnion(G, H *Digraph) *Digraph {
+	validateSameVertices(G, H)
+	U := NewDigraph(G.V())
+	inG := make(map[[2]int]int)
+	for _, e := range G.edges() {
+		inG[e]++
+		U.AddEdge(e[0], e[1])
+	}
+	inH := make(map[[2]int]int)
+	for _, e := range H.edges() {
+		inH[e]++
+		if inH[e] > inG[e] {
+			U.AddEdge(e[0], e[1])
+		}
+	}
+	return U
+}
+
+// EdgeIntersection returns the digraph on the vertices of G and H with the edges of both.
+// It panics if G and H do not have the same number of vertices.
+func EdgeIntersection(G, H *Digraph) *Digraph {
+	validateSameVertices(G, H)
+	I := NewDigraph(G.V())
+	inH := make(map[[2]int]int)
+	for _, e := range H.edges() {
+		inH[e]++
+	}
+	for _, e := range G.edges() {
+		if inH[e] > 0 {
+			inH[e]--
+			I.AddEdge(e[0], e[1])
+		}
+	}
+	return I
+}
+
+// Complement returns the complement of G: the digraph on the vertices of G, without parallel edges or
+// self-loops, with an edge v->w for every pair of distinct vertices v and w such that v->w is not an edge of G.
+func Complement(G *Digraph) *Digraph {
+	V := G.V()
+	C := NewDigraph(V)
+	adjacent := make([]bool, V)
+	for v := 0; v < V; v++ {
+		for _, w := range G.Adj(v) {
+			adjacent[w] = true
+		}
+		for w := 0; w < V; w++ {
+			if w != v && !adjacent[w] {
+				C.AddEdge(v, w)
+			}
+		}
+		for _, w := range G.Adj(v) {
+			adjacent[w] = false
+		}
+	}
+	return C
+}
+
+// LineGraph returns the line digraph of G, with a vertex for every edge of G and an edge from every edge u->v
+// of G to every edge v->w, and the tail and head of the edge of G for every vertex.
+func LineGraph(G *Digraph) (*Digraph, [][2]int) {
+	edges := G.edges()
+	L := NewDigraph(len(edges))
+	out := make([][]int, G.V())
+	for i, e := range edges {
+		out[e[0]] = append(out[e[0]], i)
+	}
+	for i, e := range edges {
+		for _, j := range out[e[1]] {
+			L.AddEdge(i, j)
+		}
+	}
+	return L, edges
+}
+
+// CartesianProduct returns the Cartesian product of G and H, with vertex g*H.V() + h for every vertex g of G
+// and h of H, and an edge from (g, h) to (g', h') if g = g' and h->h' is an edge of H,
+// or h = h' and g->g' is an edge of G.
+func CartesianProduct(G, H *Digraph) *Digraph {
+	P := NewDigraph(G.V() * H.V())
+	for _, e := range G.edges() {
+		for h := 0; h < H.V(); h++ {
+			P.AddEdge(e[0]*H.V()+h, e[1]*H.V()+h)
+		}
+	}
+	for g := 0; g < G.V(); g++ {
+		for _, e := range H.edges() {
+			P.AddEdge(g*H.V()+e[0], g*H.V()+e[1])
+		}
+	}
+	return P
+}
+
+// TensorProduct returns the tensor product of G and H, with vertex g*H.V() + h for every vertex g of G
+// and h of H, and an edge from (g, h) to (g', h') if g->g' is an edge of G and h->h' is an edge of H.
+func TensorProduct(G, H *Digraph) *Digraph {
+	P := NewDigraph(G.V() * H.V())
+	for _, e := range G.edges() {
+		for _, f := range H.edges() {
+			P.AddEdge(e[0]*H.V()+f[0], e[1]*H.V()+f[1])
+		}
+	}
+	return P
+}
+
+// returns the edges of this digraph as tail and head, in the order of the adjacency lists
+func (dg *Digraph) edges() (edges [][2]int) {
+	for v := 0; v < dg.v; v++ {
+		for _, w := range dg.adj[v].Values() {
+			edges = append(edges, [2]int{v, w.(int)})
+		}
+	}
+	return edges
+}
+
+func validateSameVertices(G, H *Digraph) {
+	if G.V() != H.V() {
+		panic(fmt.Sprintln("digraphs have ", G.V(), " and ", H.V(), " vertices"))
+	}
+}
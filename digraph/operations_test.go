@@ -0,0 +1,104 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the directed cycle 0->1->...->(V-1)->0
+func cycleDigraph(V int) *Digraph {
+	G := NewDigraph(V)
+	for v := 0; v < V; v++ {
+		G.AddEdge(v, (v+1)%V)
+	}
+	return G
+}
+
+func TestDisjointUnion(t *testing.T) {
+	assert := assert.New(t)
+	U := DisjointUnion(cycleDigraph(2), cycleDigraph(3))
+	assert.Equal(5, U.V())
+	assert.Equal(5, U.E())
+	assert.Equal([]int{0}, U.Adj(1))
+	assert.Equal([]int{2}, U.Adj(4))
+	assert.Equal(2, NewKosarajuSharirSCC(U).Count())
+}
+
+func TestEdgeUnionIntersection(t *testing.T) {
+	assert := assert.New(t)
+	G := NewDigraph(3)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	H := NewDigraph(3)
+	H.AddEdge(0, 1)
+	H.AddEdge(2, 1)
+
+	U := EdgeUnion(G, H)
+	assert.Equal(4, U.E())
+	assert.Equal([]int{1, 1}, U.Adj(0))
+	assert.Equal([]int{1}, U.Adj(2))
+
+	I := EdgeIntersection(G, H)
+	assert.Equal(1, I.E())
+	assert.Equal([]int{1}, I.Adj(0))
+
+	assert.Panics(func() { EdgeIntersection(G, NewDigraph(4)) })
+}
+
+func TestComplement(t *testing.T) {
+	assert := assert.New(t)
+	G := cycleDigraph(3)
+	G.AddEdge(0, 0)
+	C := Complement(G)
+	assert.Equal(3, C.E())
+	assert.Equal([]int{2}, C.Adj(0))
+	assert.Equal([]int{0}, C.Adj(1))
+	assert.Equal(6, Complement(NewDigraph(3)).E())
+}
+
+func TestLineGraph(t *testing.T) {
+	assert := assert.New(t)
+	L, edges := LineGraph(cycleDigraph(3))
+	assert.Equal([][2]int{{0, 1}, {1, 2}, {2, 0}}, edges)
+	assert.Equal(3, L.E())
+	assert.Equal([]int{1}, L.Adj(0))
+	assert.Equal([]int{0}, L.Adj(2))
+
+	G := NewDigraph(2)
+	G.AddEdge(0, 0)
+	G.AddEdge(0, 1)
+	L, edges = LineGraph(G)
+	assert.Equal([][2]int{{0, 1}, {0, 0}}, edges)
+	assert.Equal(2, L.E())
+	assert.Equal(0, L.OutDegree(0))
+	assert.ElementsMatch([]int{0, 1}, L.Adj(1))
+}
+
+func TestCartesianProduct(t *testing.T) {
+	assert := assert.New(t)
+	// the torus of two cycles
+	P := CartesianProduct(cycleDigraph(2), cycleDigraph(3))
+	assert.Equal(6, P.V())
+	assert.Equal(12, P.E())
+	assert.ElementsMatch([]int{4, 2}, P.Adj(1))
+	for v := 0; v < P.V(); v++ {
+		assert.Equal(2, P.InDegree(v))
+	}
+	assert.Equal(1, NewKosarajuSharirSCC(P).Count())
+}
+
+func TestTensorProduct(t *testing.T) {
+	assert := assert.New(t)
+	// the product of cycles of coprime lengths is a single cycle
+	P := TensorProduct(cycleDigraph(2), cycleDigraph(3))
+	assert.Equal(6, P.V())
+	assert.Equal(6, P.E())
+	assert.Equal([]int{4}, P.Adj(0))
+	assert.Equal(1, NewKosarajuSharirSCC(P).Count())
+
+	// and of lengths with a common factor splits
+	P = TensorProduct(cycleDigraph(2), cycleDigraph(2))
+	assert.Equal(2, NewKosarajuSharirSCC(P).Count())
+}
@@ -0,0 +1,174 @@
+package digraph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// maximum number of power iterations before giving up on convergence
+const pageRankMaxIterations = 1000
+
+// Dangling describes where the rank of a dangling vertex (a vertex with no outgoing edges) goes
+// in each step of PageRank.
+type Dangling int
+
+const (
+	// DanglingTeleport spreads the rank of dangling vertices like a random jump: uniformly for PageRank,
+	// over the source set for personalized PageRank.
+	DanglingTeleport Dangling = iota
+	// DanglingUniform spreads the rank of dangling vertices uniformly over all vertices.
+	DanglingUniform
+	// DanglingSelfLoop keeps the rank of a dangling vertex on it, as if it had a self-loop.
+	DanglingSelfLoop
+)
+
+// PageRank struct represents a data type for computing the PageRank of every vertex in a digraph:
+// the stationary distribution of a random surfer who, with probability damping, follows a uniformly
+// random outgoing edge and otherwise jumps to a random vertex (any vertex, or a vertex of a source set
+// for personalized PageRank). Parallel edges are followed in proportion to their multiplicity.
+// This implementation uses power iteration, stopping when the L1 distance between consecutive rank
+// vectors is less than the tolerance or after 1000 iterations.
+// The constructor takes O(V + E) time per iteration, where V is the number of vertices and E is the
+// number of edges. The Rank method takes O(1) time and the Top method takes O(V log V) time.
+// It uses O(V + E) extra space (not including the digraph).
+type PageRank struct {
+	rank       []float64 // rank[v] = PageRank of v
+	iterations int       // number of power iterations performed
+	converged  bool      // did the iteration converge within the tolerance?
+}
+
+// NewPageRank computes the PageRank of every vertex in the digraph G with the given damping factor
+// (the probability of following an edge, in [0, 1)), convergence tolerance and dangling-vertex policy.
+func NewPageRank(G *Digraph, damping, tolerance float64, dangling Dangling) *PageRank {
+	teleport := make([]float64, G.V())
+	for v := range teleport {
+		teleport[v] = 1.0 / float64(G.V())
+	}
+	return newPageRank(G, teleport, damping, tolerance, dangling)
+}
+
+// NewPersonalizedPageRank computes the PageRank of every vertex in the digraph G personalized to the
+// given source vertices: every random jump lands on a uniformly random source.
+func NewPersonalizedPageRank(G *Digraph, sources []int, damping, tolerance float64, dangling Dangling) *PageRank {
+	if len(sources) == 0 {
+		panic("source set is empty")
+	}
+	teleport := make([]float64, G.V())
+	for _, s := range sources {
+		G.validateVertex(s)
+		teleport[s] += 1.0 / float64(len(sources))
+	}
+	return newPageRank(G, teleport, damping, tolerance, dangling)
+}
+
+func newPageRank(G *Digraph, teleport []float64, damping, tolerance float64, dangling Dangling) *PageRank {
+	if !(damping >= 0 && damping < 1) {
+		panic("damping factor must be between 0 and 1")
+	}
+	if !(tolerance > 0) {
+		panic("tolerance must be positive")
+	}
+	if dangling < DanglingTeleport || dangling > DanglingSelfLoop {
+		panic("unknown dangling policy")
+	}
+	V := G.V()
+	pr := &PageRank{rank: make([]float64, V)}
+	if V == 0 {
+		pr.converged = true
+		return pr
+	}
+	adj := make([][]int, V)
+	for v := 0; v < V; v++ {
+		adj[v] = G.Adj(v)
+	}
+
+	copy(pr.rank, teleport)
+	next := make([]float64, V)
+	for pr.iterations < pageRankMaxIterations && !pr.converged {
+		pr.iterations++
+		danglingRank := 0.0
+		for w := range next {
+			next[w] = (1 - damping) * teleport[w]
+		}
+		for v := 0; v < V; v++ {
+			if len(adj[v]) == 0 {
+				if dangling == DanglingSelfLoop {
+					next[v] += damping * pr.rank[v]
+				} else {
+					danglingRank += pr.rank[v]
+				}
+				continue
+			}
+			share := damping * pr.rank[v] / float64(len(adj[v]))
+			for _, w := range adj[v] {
+				next[w] += share
+			}
+		}
+		if danglingRank > 0 {
+			for w := range next {
+				if dangling == DanglingTeleport {
+					next[w] += damping * danglingRank * teleport[w]
+				} else {
+					next[w] += damping * danglingRank / float64(V)
+				}
+			}
+		}
+
+		diff := 0.0
+		for v := range next {
+			diff += math.Abs(next[v] - pr.rank[v])
+		}
+		pr.rank, next = next, pr.rank
+		pr.converged = diff < tolerance
+	}
+	return pr
+}
+
+// Rank returns the PageRank of vertex v; the ranks of all vertices sum to 1.
+func (pr *PageRank) Rank(v int) float64 {
+	pr.validateVertex(v)
+	return pr.rank[v]
+}
+
+// Ranks returns the PageRank of every vertex.
+func (pr *PageRank) Ranks() (ranks []float64) {
+	ranks = make([]float64, len(pr.rank))
+	copy(ranks, pr.rank)
+	return ranks
+}
+
+// Top returns the k vertices of highest PageRank in descending order of rank, breaking ties by vertex index.
+func (pr *PageRank) Top(k int) []int {
+	if k < 0 {
+		panic("k must be non negative")
+	}
+	vertices := make([]int, len(pr.rank))
+	for v := range vertices {
+		vertices[v] = v
+	}
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return pr.rank[vertices[i]] > pr.rank[vertices[j]]
+	})
+	if k < len(vertices) {
+		vertices = vertices[:k]
+	}
+	return vertices
+}
+
+// Iterations returns the number of power iterations performed.
+func (pr *PageRank) Iterations() int {
+	return pr.iterations
+}
+
+// Converged returns true if the ranks converged within the tolerance.
+func (pr *PageRank) Converged() bool {
+	return pr.converged
+}
+
+func (pr *PageRank) validateVertex(v int) {
+	V := len(pr.rank)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
@@ -0,0 +1,56 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRank(t *testing.T) {
+	assert := assert.New(t)
+
+	// every vertex of a cycle has the same rank
+	G := NewDigraphGenerator().CycleDigraph(4)
+	pr := NewPageRank(G, 0.85, 1e-12, DanglingTeleport)
+	assert.True(pr.Converged())
+	for v := 0; v < G.V(); v++ {
+		assert.InDelta(0.25, pr.Rank(v), 1e-9)
+	}
+
+	// 0->1 where 1 is dangling
+	G = NewDigraph(2)
+	G.AddEdge(0, 1)
+	pr = NewPageRank(G, 0.85, 1e-12, DanglingTeleport)
+	assert.InDelta(0.5/1.425, pr.Rank(0), 1e-9)
+	assert.InDelta(1-0.5/1.425, pr.Rank(1), 1e-9)
+	assert.Equal([]int{1}, pr.Top(1))
+	assert.Equal([]int{1, 0}, pr.Top(5))
+	assert.Greater(pr.Iterations(), 1)
+
+	pr = NewPageRank(G, 0.85, 1e-12, DanglingSelfLoop)
+	assert.InDelta(0.075, pr.Rank(0), 1e-9)
+	assert.InDelta(0.925, pr.Rank(1), 1e-9)
+
+	pr = NewPersonalizedPageRank(G, []int{0}, 0.85, 1e-12, DanglingTeleport)
+	assert.InDelta(0.15/0.2775, pr.Rank(0), 1e-9)
+	assert.InDelta(1-0.15/0.2775, pr.Rank(1), 1e-9)
+
+	pr = NewPersonalizedPageRank(G, []int{0}, 0.85, 1e-12, DanglingUniform)
+	assert.InDelta(0.15/(1-0.425*0.85/0.575), pr.Rank(0), 1e-9)
+	ranks := pr.Ranks()
+	assert.InDelta(1.0, ranks[0]+ranks[1], 1e-9)
+
+	// without damping every vertex keeps its teleport probability
+	pr = NewPersonalizedPageRank(G, []int{1, 1, 0}, 0, 1e-12, DanglingTeleport)
+	assert.InDelta(1.0/3, pr.Rank(0), 1e-12)
+	assert.Equal(1, pr.Iterations())
+
+	assert.Panics(func() { NewPageRank(G, 1.0, 1e-6, DanglingTeleport) })
+	assert.Panics(func() { NewPageRank(G, 0.85, 0, DanglingTeleport) })
+	assert.Panics(func() { NewPageRank(G, 0.85, 1e-6, Dangling(7)) })
+	assert.Panics(func() { NewPersonalizedPageRank(G, nil, 0.85, 1e-6, DanglingTeleport) })
+	assert.Panics(func() { NewPersonalizedPageRank(G, []int{2}, 0.85, 1e-6, DanglingTeleport) })
+	assert.Panics(func() { pr.Rank(2) })
+	assert.Panics(func() { pr.Top(-1) })
+	assert.Empty(NewPageRank(NewDigraph(0), 0.85, 1e-6, DanglingTeleport).Top(3))
+}
@@ -0,0 +1,243 @@
+package digraph
+
+import "sort"
+
+// SteinerTree struct represents a data type for approximating a minimum Steiner tree in an edge-weighted graph:
+// a tree of least total weight that connects a given set of terminal vertices, possibly through other vertices.
+// If the terminals lie in several connected components, the result is a forest connecting the terminals of each.
+// Both constructors build a minimum spanning tree of the metric closure of the terminals (the complete graph on
+// the terminals weighted by shortest-path distance), replace its edges by shortest paths, take a minimum spanning
+// tree of the union of those paths and prune leaves that are not terminals. The tree weighs at most
+// 2 (1 - 1/k) times the optimum, where k is the number of terminals.
+// The Kou–Markowsky–Berman constructor runs Dijkstra's algorithm from every terminal (see DijkstraSP) and Kruskal's
+// algorithm on the closure (see KruskalMST); it takes O(k E log V + k^2 log k) time and uses O(k V + k^2) extra space.
+// The Mehlhorn constructor runs Dijkstra's algorithm once from all terminals together, splitting the vertices into
+// regions by nearest terminal, and uses only the closure edges induced by edges between regions; it takes
+// O(E log V) time and uses O(V + E) extra space. Each instance method takes O(1) time, except Edges,
+// which takes O(V) time. Neither counts the space of the graph.
+type SteinerTree struct {
+	weight float64 // total weight of the tree
+	edges  []*Edge // edges of the tree
+}
+
+// NewSteinerTree computes an approximate Steiner tree of the terminals in the edge-weighted graph G,
+// whose edge weights must be nonnegative, using the algorithm of Kou, Markowsky and Berman.
+func NewSteinerTree(G *EdgeWeightedGraph, terminals []int) *SteinerTree {
+	terminals = distinctTerminals(G, terminals)
+	D, original := steinerDigraph(G, false)
+	k := len(terminals)
+	sp := make([]*DijkstraSP, k)
+	for i, s := range terminals {
+		sp[i] = NewDijkstraSP(D, s)
+	}
+
+	closure := NewEdgeWeightedGraphV(k)
+	for i := 0; i < k; i++ {
+		for j := i + 1; j < k; j++ {
+			if sp[i].HasPathTo(terminals[j]) {
+				closure.AddEdge(NewEdge(i, j, sp[i].DistTo(terminals[j])))
+			}
+		}
+	}
+	var paths []*Edge
+	for _, e := range NewKruskalMST(closure).Edges() {
+		i := e.Either()
+		for _, f := range sp[i].PathTo(terminals[e.Other(i)]) {
+			paths = append(paths, original[f])
+		}
+	}
+	return newSteinerTree(G, terminals, paths)
+}
+
+// NewMehlhornSteinerTree computes an approximate Steiner tree of the terminals in the edge-weighted graph G,
+// whose edge weights must be nonnegative, using Mehlhorn's algorithm.
+func NewMehlhornSteinerTree(G *EdgeWeightedGraph, terminals []int) *SteinerTree {
+	terminals = distinctTerminals(G, terminals)
+	// a shortest-paths tree from an extra source joined to every terminal by an edge of weight 0
+	D, original := steinerDigraph(G, true)
+	source := G.V()
+	for _, t := range terminals {
+		D.AddEdge(NewDirectedEdge(source, t, 0))
+	}
+	sp := NewDijkstraSP(D, source)
+
+	// region[v] = index of the terminal nearest to v, -1 if v is not connected to any terminal
+	region := make([]int, G.V())
+	for v := range region {
+		region[v] = -1
+	}
+	for i, t := range terminals {
+		region[t] = i
+	}
+	for v := range region {
+		if !sp.HasPathTo(v) {
+			continue
+		}
+		var chain []int
+		for w := v; region[w] < 0; w = sp.edgeTo[w].From() {
+			chain = append(chain, w)
+		}
+		r := region[v]
+		if len(chain) > 0 {
+			r = region[sp.edgeTo[chain[len(chain)-1]].From()]
+		}
+		for _, w := range chain {
+			region[w] = r
+		}
+	}
+
+	// the lightest edge between every pair of adjacent regions, as a path between their terminals
+	type bridge struct {
+		weight float64
+		edge   *Edge
+	}
+	k := len(terminals)
+	bridges := make(map[[2]int]bridge)
+	for _, e := range G.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		if region[v] < 0 || region[w] < 0 || region[v] == region[w] {
+			continue
+		}
+		key := [2]int{region[v], region[w]}
+		if key[0] > key[1] {
+			key[0], key[1] = key[1], key[0]
+		}
+		weight := sp.DistTo(v) + e.Weight() + sp.DistTo(w)
+		if b, ok := bridges[key]; !ok || weight < b.weight {
+			bridges[key] = bridge{weight, e}
+		}
+	}
+	keys := make([][2]int, 0, len(bridges))
+	for key := range bridges {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i][0] < keys[j][0] || keys[i][0] == keys[j][0] && keys[i][1] < keys[j][1]
+	})
+	closure := NewEdgeWeightedGraphV(k)
+	through := make(map[*Edge]*Edge)
+	for _, key := range keys {
+		b := bridges[key]
+		e := NewEdge(key[0], key[1], b.weight)
+		through[e] = b.edge
+		closure.AddEdge(e)
+	}
+
+	var paths []*Edge
+	for _, e := range NewKruskalMST(closure).Edges() {
+		bridge := through[e]
+		paths = append(paths, bridge)
+		v := bridge.Either()
+		for _, w := range []int{v, bridge.Other(v)} {
+			for ; sp.edgeTo[w].From() != source; w = sp.edgeTo[w].From() {
+				paths = append(paths, original[sp.edgeTo[w]])
+			}
+		}
+	}
+	return newSteinerTree(G, terminals, paths)
+}
+
+// returns the terminals without repetitions, in the order given
+func distinctTerminals(G *EdgeWeightedGraph, terminals []int) (distinct []int) {
+	seen := make([]bool, G.V())
+	for _, t := range terminals {
+		G.validateVertex(t)
+		if !seen[t] {
+			seen[t] = true
+			distinct = append(distinct, t)
+		}
+	}
+	return distinct
+}
+
+// returns an edge-weighted digraph with an edge in each direction for every edge of G, with one more vertex
+// if extra is true, and the edge of G from which each directed edge was made
+func steinerDigraph(G *EdgeWeightedGraph, extra bool) (*EdgeWeightedDigraph, map[*DirectedEdge]*Edge) {
+	V := G.V()
+	if extra {
+		V++
+	}
+	D := NewEdgeWeightedDigraphV(V)
+	original := make(map[*DirectedEdge]*Edge)
+	for _, e := range G.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		forward, backward := NewDirectedEdge(v, w, e.Weight()), NewDirectedEdge(w, v, e.Weight())
+		original[forward], original[backward] = e, e
+		D.AddEdge(forward)
+		D.AddEdge(backward)
+	}
+	return D, original
+}
+
+// finishes with a minimum spanning tree of the given edges, repeatedly removing leaves that are not terminals
+func newSteinerTree(G *EdgeWeightedGraph, terminals []int, paths []*Edge) *SteinerTree {
+	H := NewEdgeWeightedGraphV(G.V())
+	added := make(map[*Edge]bool)
+	for _, e := range paths {
+		if !added[e] {
+			added[e] = true
+			H.AddEdge(e)
+		}
+	}
+	mst := NewKruskalMST(H).Edges()
+
+	terminal := make([]bool, G.V())
+	for _, t := range terminals {
+		terminal[t] = true
+	}
+	degree := make([]int, G.V())
+	incident := make([][]*Edge, G.V())
+	for _, e := range mst {
+		v := e.Either()
+		w := e.Other(v)
+		degree[v]++
+		degree[w]++
+		incident[v] = append(incident[v], e)
+		incident[w] = append(incident[w], e)
+	}
+	removed := make(map[*Edge]bool)
+	var leaves []int
+	for v := range degree {
+		if degree[v] == 1 && !terminal[v] {
+			leaves = append(leaves, v)
+		}
+	}
+	for len(leaves) > 0 {
+		v := leaves[len(leaves)-1]
+		leaves = leaves[:len(leaves)-1]
+		for _, e := range incident[v] {
+			if removed[e] {
+				continue
+			}
+			removed[e] = true
+			degree[v]--
+			w := e.Other(v)
+			if degree[w]--; degree[w] == 1 && !terminal[w] {
+				leaves = append(leaves, w)
+			}
+		}
+	}
+
+	st := &SteinerTree{}
+	for _, e := range mst {
+		if !removed[e] {
+			st.edges = append(st.edges, e)
+			st.weight += e.Weight()
+		}
+	}
+	return st
+}
+
+// Edges returns the edges of the Steiner tree (or forest), which are edges of the graph.
+func (st *SteinerTree) Edges() (edges []*Edge) {
+	edges = make([]*Edge, len(st.edges))
+	copy(edges, st.edges)
+	return edges
+}
+
+// Weight returns the sum of the edge weights of the Steiner tree (or forest).
+func (st *SteinerTree) Weight() float64 {
+	return st.weight
+}
@@ -0,0 +1,131 @@
+package digraph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/handane123/algorithms/fundamentals/unionfind"
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the weight of a minimum Steiner tree by trying the minimum spanning forest of every set of vertices
+// that contains the terminals, assuming the terminals are connected
+func bruteForceSteiner(G *EdgeWeightedGraph, terminals []int) float64 {
+	V := G.V()
+	required := 0
+	for _, t := range terminals {
+		required |= 1 << t
+	}
+	best := math.Inf(1)
+	for S := 0; S < 1<<V; S++ {
+		if S&required != required {
+			continue
+		}
+		H := NewEdgeWeightedGraphV(V)
+		for _, e := range G.Edges() {
+			v := e.Either()
+			if S&(1<<v) != 0 && S&(1<<e.Other(v)) != 0 {
+				H.AddEdge(e)
+			}
+		}
+		mst := NewKruskalMST(H)
+		uf := unionfind.NewUF(V)
+		for _, e := range mst.Edges() {
+			v := e.Either()
+			uf.Union(v, e.Other(v))
+		}
+		connected := true
+		for _, t := range terminals {
+			connected = connected && uf.Find(t) == uf.Find(terminals[0])
+		}
+		if connected {
+			best = math.Min(best, mst.Weight())
+		}
+	}
+	return best
+}
+
+// checks that the edges form a forest that connects the terminals and whose leaves are terminals
+func assertSteinerTree(assert *assert.Assertions, G *EdgeWeightedGraph, terminals []int, st *SteinerTree) {
+	uf := unionfind.NewUF(G.V())
+	degree := make([]int, G.V())
+	weight := 0.0
+	for _, e := range st.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		assert.NotEqual(uf.Find(v), uf.Find(w))
+		uf.Union(v, w)
+		degree[v]++
+		degree[w]++
+		weight += e.Weight()
+	}
+	assert.InDelta(weight, st.Weight(), 1e-9)
+	terminal := make([]bool, G.V())
+	for _, t := range terminals {
+		terminal[t] = true
+		assert.Equal(uf.Find(t), uf.Find(terminals[0]))
+	}
+	for v, d := range degree {
+		assert.False(d == 1 && !terminal[v])
+	}
+}
+
+func TestSteinerTree(t *testing.T) {
+	assert := assert.New(t)
+
+	// star with a cheap center that is not a terminal, around a ring of expensive edges
+	G := NewEdgeWeightedGraphV(5)
+	for v := 1; v < 5; v++ {
+		G.AddEdge(NewEdge(0, v, 1))
+		G.AddEdge(NewEdge(v, v%4+1, 1.9))
+	}
+	G.AddEdge(NewEdge(2, 2, 0.1))
+	terminals := []int{1, 2, 3, 4, 2}
+	for _, st := range []*SteinerTree{NewSteinerTree(G, terminals), NewMehlhornSteinerTree(G, terminals)} {
+		assertSteinerTree(assert, G, terminals, st)
+		assert.True(st.Weight() <= 2*(1-1.0/4)*4)
+	}
+
+	// a single terminal, or none
+	assert.Empty(NewSteinerTree(G, []int{3}).Edges())
+	assert.Equal(0.0, NewMehlhornSteinerTree(G, nil).Weight())
+
+	// terminals in different components
+	H := NewEdgeWeightedGraphV(6)
+	H.AddEdge(NewEdge(0, 1, 2))
+	H.AddEdge(NewEdge(1, 2, 2))
+	H.AddEdge(NewEdge(3, 4, 1))
+	H.AddEdge(NewEdge(4, 5, 1))
+	for _, st := range []*SteinerTree{NewSteinerTree(H, []int{0, 2, 5}), NewMehlhornSteinerTree(H, []int{0, 2, 5})} {
+		assert.Equal(4.0, st.Weight())
+		assert.Len(st.Edges(), 2)
+	}
+
+	assert.Panics(func() { NewSteinerTree(G, []int{5}) })
+	assert.Panics(func() { NewMehlhornSteinerTree(G, []int{-1}) })
+}
+
+func TestSteinerTreeRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(38))
+	for trial := 0; trial < 50; trial++ {
+		V := 4 + r.Intn(7)
+		G := NewEdgeWeightedGraphV(V)
+		// a random spanning tree plus random edges keeps the graph connected
+		for v := 1; v < V; v++ {
+			G.AddEdge(NewEdge(r.Intn(v), v, float64(1+r.Intn(9))))
+		}
+		for i := 0; i < V; i++ {
+			G.AddEdge(NewEdge(r.Intn(V), r.Intn(V), float64(1+r.Intn(9))))
+		}
+		k := 2 + r.Intn(V-1)
+		terminals := r.Perm(V)[:k]
+		optimum := bruteForceSteiner(G, terminals)
+		for _, st := range []*SteinerTree{NewSteinerTree(G, terminals), NewMehlhornSteinerTree(G, terminals)} {
+			assertSteinerTree(assert, G, terminals, st)
+			assert.True(st.Weight() >= optimum-1e-9)
+			assert.True(st.Weight() <= 2*(1-1/float64(k))*optimum+1e-9)
+		}
+	}
+}
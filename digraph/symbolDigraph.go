@@ -6,6 +6,7 @@ import (
 
 	"github.com/handane123/algorithms/io/stdin"
 	"github.com/handane123/algorithms/searching"
+	"github.com/pkg/errors"
 )
 
 // SymbolDigraph struct represents an digraph,
@@ -80,6 +81,23 @@ func NewSymbolDigraph(filename, delimiter string) *SymbolDigraph {
 	return sg
 }
 
+// returns the symbol digraph of G with vertex v named names[v], or an error if two vertices have the same name
+func newSymbolDigraphNames(names []string, G *Digraph) (*SymbolDigraph, error) {
+	sg := &SymbolDigraph{st: searching.NewST(func(a, b interface{}) int {
+		a1, b1 := a.(key), b.(key)
+		return a1.CompareTo(b1)
+	}), keys: make([]key, len(names)), graph: G}
+	for v, name := range names {
+		if ok, _ := sg.st.Contains(key(name)); ok {
+			return nil, errors.Errorf("duplicate vertex name %q", name)
+		}
+		//nolint:errcheck
+		sg.st.Put(key(name), v)
+		sg.keys[v] = key(name)
+	}
+	return sg, nil
+}
+
 // Contains returns ture if the digraph contain the vertex named s
 func (sg *SymbolDigraph) Contains(s string) bool {
 	ok, _ := sg.st.Contains(key(s))
@@ -16,7 +16,7 @@ type Topological struct {
 
 // NewTopological determines whether the digraph G has a topological order and, if so,
 // finds such a topological order.
-func NewTopological(G *Digraph) *Topological {
+func NewTopological(G DigraphView) *Topological {
 	top := &Topological{rank: make([]int, G.V())}
 	finder := NewDirectedCycle(G)
 	if !finder.HasCycle() {
@@ -30,7 +30,7 @@ func NewTopological(G *Digraph) *Topological {
 }
 
 // NewTopologicalEWD determines whether the edge-weighted digraph G has a topological order and, if so, finds such an order.
-func NewTopologicalEWD(G *EdgeWeightedDigraph) *Topological {
+func NewTopologicalEWD(G EdgeWeightedDigraphView) *Topological {
 	top := &Topological{rank: make([]int, G.V())}
 	finder := NewEdgeWeightedDirectedCycle(G)
 	if !finder.HasCycle() {
@@ -12,7 +12,7 @@ type TransitiveClosure struct {
 }
 
 // NewTransitiveClosure computes the transitive closure of the digraph G.
-func NewTransitiveClosure(G *Digraph) *TransitiveClosure {
+func NewTransitiveClosure(G DigraphView) *TransitiveClosure {
 	tc := make([]*DirectedDFS, G.V())
 	for v := 0; v < G.V(); v++ {
 		tc[v] = NewDirectedDFS(G, v)
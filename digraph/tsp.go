@@ -0,0 +1,338 @@
+package digraph
+
+import (
+	"fmt"
+	"math"
+)
+
+// largest number of vertices accepted by NewHeldKarpTSP
+const heldKarpMaxVertices = 20
+
+// TSP struct represents a data type for finding a short tour in an edge-weighted graph: a cycle that visits
+// every vertex exactly once and returns to its start (the traveling salesperson problem).
+// The graph is treated as complete: the distance between two vertices is the smallest weight of an edge joining
+// them, or +Inf if there is none, so a tour of finite weight exists only if the graph has a Hamiltonian cycle.
+// The tour of a single vertex has weight 0, and the tour of two vertices uses the edge between them twice.
+// The constructors build a distance matrix in O(V^2 + E) time and then take:
+// O(2^V V^2) time for Held–Karp dynamic programming, which finds an optimal tour and accepts at most 20 vertices;
+// O(E log V + V) time for the preorder walk of a minimum spanning tree (see PrimMST), whose tour weighs at most
+// twice the optimum when the weights satisfy the triangle inequality;
+// O(V^2) time for the nearest-neighbor heuristic;
+// O(V^2) time per improving move for the 2-opt and Or-opt local searches, which improve a given tour until no
+// move helps. Each instance method takes O(1) time, except Tour and Edges, which take O(V) time.
+// It uses O(V^2) extra space (O(2^V V) for Held–Karp), not including the graph.
+type TSP struct {
+	tour   []int       // vertices in the order visited
+	weight float64     // total weight of the tour
+	dist   [][]float64 // dist[v][w] = distance between v and w
+	edge   [][]*Edge   // edge[v][w] = lightest edge between v and w, nil if none
+}
+
+// NewHeldKarpTSP computes an optimal tour of the edge-weighted graph G, which must have at most 20 vertices.
+func NewHeldKarpTSP(G *EdgeWeightedGraph) *TSP {
+	if G.V() > heldKarpMaxVertices {
+		panic(fmt.Sprintln("Held-Karp accepts at most ", heldKarpMaxVertices, " vertices"))
+	}
+	t := newTSP(G)
+	V := G.V()
+	if V <= 2 {
+		for v := 0; v < V; v++ {
+			t.tour = append(t.tour, v)
+		}
+		return t.finish()
+	}
+
+	// cost[S][j] = weight of a shortest path from vertex 0 through the vertices of S ending at vertex j + 1,
+	// where S is a set of vertices 1 through V - 1 encoded as a bit mask that contains j + 1
+	n := V - 1
+	cost := make([][]float64, 1<<n)
+	for S := range cost {
+		cost[S] = make([]float64, n)
+		for j := range cost[S] {
+			cost[S][j] = math.Inf(1)
+		}
+	}
+	for j := 0; j < n; j++ {
+		cost[1<<j][j] = t.dist[0][j+1]
+	}
+	for S := 1; S < 1<<n; S++ {
+		for j := 0; j < n; j++ {
+			if S&(1<<j) == 0 || S == 1<<j {
+				continue
+			}
+			prev := S &^ (1 << j)
+			for i := 0; i < n; i++ {
+				if prev&(1<<i) != 0 {
+					if c := cost[prev][i] + t.dist[i+1][j+1]; c < cost[S][j] {
+						cost[S][j] = c
+					}
+				}
+			}
+		}
+	}
+
+	// close the tour, then retrace the choices backwards
+	full := 1<<n - 1
+	last, best := 0, math.Inf(1)
+	for j := 0; j < n; j++ {
+		if c := cost[full][j] + t.dist[j+1][0]; c < best || j == 0 {
+			last, best = j, c
+		}
+	}
+	reversed := []int{last + 1}
+	for S := full; S != 1<<last; {
+		prev := S &^ (1 << last)
+		for i := 0; i < n; i++ {
+			if prev&(1<<i) != 0 && cost[prev][i]+t.dist[i+1][last+1] == cost[S][last] {
+				S, last = prev, i
+				break
+			}
+		}
+		if S != prev {
+			// no finite predecessor: the remaining vertices can be visited in any order
+			S, last = prev, lowestBit(prev)
+		}
+		reversed = append(reversed, last+1)
+	}
+	t.tour = append(t.tour, 0)
+	for i := len(reversed) - 1; i >= 0; i-- {
+		t.tour = append(t.tour, reversed[i])
+	}
+	return t.finish()
+}
+
+// returns the index of the lowest set bit of a nonzero mask
+func lowestBit(mask int) int {
+	i := 0
+	for mask&(1<<i) == 0 {
+		i++
+	}
+	return i
+}
+
+// NewMSTApproxTSP computes a tour of the edge-weighted graph G by visiting the vertices in preorder
+// of a minimum spanning tree, starting from vertex 0.
+func NewMSTApproxTSP(G *EdgeWeightedGraph) *TSP {
+	t := newTSP(G)
+	V := G.V()
+	tree := make([][]int, V)
+	for _, e := range NewPrimMST(G).Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		tree[v] = append(tree[v], w)
+		tree[w] = append(tree[w], v)
+	}
+	// nonrecursive preorder; a forest is walked one tree after another
+	marked := make([]bool, V)
+	for s := 0; s < V; s++ {
+		if marked[s] {
+			continue
+		}
+		stack := []int{s}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if marked[v] {
+				continue
+			}
+			marked[v] = true
+			t.tour = append(t.tour, v)
+			for i := len(tree[v]) - 1; i >= 0; i-- {
+				if !marked[tree[v][i]] {
+					stack = append(stack, tree[v][i])
+				}
+			}
+		}
+	}
+	return t.finish()
+}
+
+// NewNearestNeighborTSP computes a tour of the edge-weighted graph G that starts at vertex s and repeatedly
+// moves to the nearest unvisited vertex.
+func NewNearestNeighborTSP(G *EdgeWeightedGraph, s int) *TSP {
+	G.validateVertex(s)
+	t := newTSP(G)
+	visited := make([]bool, G.V())
+	for v := s; v >= 0; {
+		visited[v] = true
+		t.tour = append(t.tour, v)
+		next := -1
+		for w := range visited {
+			if !visited[w] && (next < 0 || t.dist[v][w] < t.dist[v][next]) {
+				next = w
+			}
+		}
+		v = next
+	}
+	return t.finish()
+}
+
+// NewTwoOptTSP improves the given tour of the edge-weighted graph G by reversing segments of it
+// (replacing two edges of the tour by two others) until no reversal makes it shorter.
+func NewTwoOptTSP(G *EdgeWeightedGraph, tour []int) *TSP {
+	t := newTSPTour(G, tour)
+	n := len(t.tour)
+	for improved := n > 3; improved; {
+		improved = false
+		for i := 0; i < n-1; i++ {
+			for j := i + 2; j < n; j++ {
+				a, b := t.tour[i], t.tour[i+1]
+				c, d := t.tour[j], t.tour[(j+1)%n]
+				if a == d {
+					continue
+				}
+				// replace a-b and c-d by a-c and b-d
+				if t.dist[a][c]+t.dist[b][d] < t.dist[a][b]+t.dist[c][d]-tspEpsilon(t.dist[a][b]+t.dist[c][d]) {
+					for l, r := i+1, j; l < r; l, r = l+1, r-1 {
+						t.tour[l], t.tour[r] = t.tour[r], t.tour[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+	return t.finish()
+}
+
+// NewOrOptTSP improves the given tour of the edge-weighted graph G by moving segments of one, two or three
+// consecutive vertices, possibly reversed, to other places in the tour until no move makes it shorter.
+func NewOrOptTSP(G *EdgeWeightedGraph, tour []int) *TSP {
+	t := newTSPTour(G, tour)
+	n := len(t.tour)
+	for improved := n > 3; improved; {
+		improved = false
+		for length := 1; length <= 3 && length <= n-3; length++ {
+			for i := 0; i < n && !improved; i++ {
+				improved = t.moveSegment(i, length)
+			}
+		}
+	}
+	return t.finish()
+}
+
+// tries to move the segment of the given length starting at position i to a place where it shortens the tour;
+// returns true if it was moved
+func (t *TSP) moveSegment(i, length int) bool {
+	n := len(t.tour)
+	at := func(k int) int { return t.tour[(k%n+n)%n] }
+	p, first, last, q := at(i-1), at(i), at(i+length-1), at(i+length)
+	removed := t.dist[p][first] + t.dist[last][q] - t.dist[p][q]
+	// try every edge x-y outside the segment
+	for k := i + length; k < i+n-1; k++ {
+		x, y := at(k), at(k+1)
+		added := t.dist[x][first] + t.dist[last][y] - t.dist[x][y]
+		reversedAdded := t.dist[x][last] + t.dist[first][y] - t.dist[x][y]
+		reverse := reversedAdded < added
+		if reverse {
+			added = reversedAdded
+		}
+		if added < removed-tspEpsilon(removed) {
+			segment := make([]int, length)
+			for s := range segment {
+				segment[s] = at(i + s)
+			}
+			if reverse {
+				for l, r := 0, length-1; l < r; l, r = l+1, r-1 {
+					segment[l], segment[r] = segment[r], segment[l]
+				}
+			}
+			// rebuild the tour from q to x, then the segment
+			rebuilt := make([]int, 0, n)
+			for m := i + length; m <= k; m++ {
+				rebuilt = append(rebuilt, at(m))
+			}
+			rebuilt = append(rebuilt, segment...)
+			for m := k + 1; m < i+n; m++ {
+				rebuilt = append(rebuilt, at(m))
+			}
+			t.tour = rebuilt
+			return true
+		}
+	}
+	return false
+}
+
+// tolerance below which a change in tour weight is treated as rounding error
+func tspEpsilon(weight float64) float64 {
+	return 1e-12 * math.Abs(weight)
+}
+
+func newTSP(G *EdgeWeightedGraph) *TSP {
+	V := G.V()
+	t := &TSP{dist: make([][]float64, V), edge: make([][]*Edge, V)}
+	for v := 0; v < V; v++ {
+		t.dist[v] = make([]float64, V)
+		t.edge[v] = make([]*Edge, V)
+		for w := range t.dist[v] {
+			if w != v {
+				t.dist[v][w] = math.Inf(1)
+			}
+		}
+	}
+	for _, e := range G.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		if v != w && e.Weight() < t.dist[v][w] {
+			t.dist[v][w], t.dist[w][v] = e.Weight(), e.Weight()
+			t.edge[v][w], t.edge[w][v] = e, e
+		}
+	}
+	return t
+}
+
+// starts from a copy of the given tour, which must contain every vertex of G exactly once
+func newTSPTour(G *EdgeWeightedGraph, tour []int) *TSP {
+	if len(tour) != G.V() {
+		panic("tour must contain every vertex exactly once")
+	}
+	seen := make([]bool, G.V())
+	for _, v := range tour {
+		G.validateVertex(v)
+		if seen[v] {
+			panic("tour must contain every vertex exactly once")
+		}
+		seen[v] = true
+	}
+	t := newTSP(G)
+	t.tour = append(t.tour, tour...)
+	return t
+}
+
+// computes the weight of the tour
+func (t *TSP) finish() *TSP {
+	t.weight = 0
+	for i, v := range t.tour {
+		if len(t.tour) > 1 {
+			t.weight += t.dist[v][t.tour[(i+1)%len(t.tour)]]
+		}
+	}
+	return t
+}
+
+// Tour returns the vertices in the order the tour visits them; the tour returns from the last to the first.
+func (t *TSP) Tour() (tour []int) {
+	tour = make([]int, len(t.tour))
+	copy(tour, t.tour)
+	return tour
+}
+
+// Edges returns the edges of the tour in order, or nil if some consecutive vertices are not adjacent.
+func (t *TSP) Edges() (edges []*Edge) {
+	if !t.HasTour() || len(t.tour) < 2 {
+		return nil
+	}
+	for i, v := range t.tour {
+		edges = append(edges, t.edge[v][t.tour[(i+1)%len(t.tour)]])
+	}
+	return edges
+}
+
+// Weight returns the total weight of the tour, +Inf if some consecutive vertices are not adjacent.
+func (t *TSP) Weight() float64 {
+	return t.weight
+}
+
+// HasTour returns true if the tour has finite weight.
+func (t *TSP) HasTour() bool {
+	return !math.IsInf(t.weight, 1)
+}
@@ -0,0 +1,164 @@
+package digraph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/handane123/algorithms/fundamentals/geometry"
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the complete graph on random points of the unit square, weighted by distance
+func euclideanTSPGraph(r *rand.Rand, V int) *EdgeWeightedGraph {
+	points := make([]*geometry.Point2D, V)
+	for i := range points {
+		points[i] = geometry.NewPoint2D(r.Float64(), r.Float64())
+	}
+	G := NewEdgeWeightedGraphV(V)
+	for v := range points {
+		for w := v + 1; w < V; w++ {
+			G.AddEdge(NewEdge(v, w, points[v].DistanceTo(points[w])))
+		}
+	}
+	return G
+}
+
+// returns the weight of a shortest tour by trying every permutation of the vertices other than 0
+func bruteForceTSP(G *EdgeWeightedGraph) float64 {
+	t := newTSP(G)
+	V := G.V()
+	tour := make([]int, V)
+	for v := range tour {
+		tour[v] = v
+	}
+	best := math.Inf(1)
+	var permute func(k int)
+	permute = func(k int) {
+		if k == V {
+			t.tour = tour
+			best = math.Min(best, t.finish().weight)
+			return
+		}
+		for i := k; i < V; i++ {
+			tour[k], tour[i] = tour[i], tour[k]
+			permute(k + 1)
+			tour[k], tour[i] = tour[i], tour[k]
+		}
+	}
+	permute(1)
+	return best
+}
+
+func assertTour(assert *assert.Assertions, G *EdgeWeightedGraph, t *TSP) {
+	tour := t.Tour()
+	assert.Len(tour, G.V())
+	seen := make([]bool, G.V())
+	for _, v := range tour {
+		assert.False(seen[v])
+		seen[v] = true
+	}
+	if t.HasTour() && len(tour) > 1 {
+		weight := 0.0
+		for _, e := range t.Edges() {
+			weight += e.Weight()
+		}
+		assert.InDelta(t.Weight(), weight, 1e-9)
+	}
+}
+
+func TestTSP(t *testing.T) {
+	assert := assert.New(t)
+
+	// square with heavy diagonals
+	G := NewEdgeWeightedGraphV(4)
+	G.AddEdge(NewEdge(0, 1, 1))
+	G.AddEdge(NewEdge(1, 2, 1))
+	G.AddEdge(NewEdge(2, 3, 1))
+	G.AddEdge(NewEdge(3, 0, 1))
+	G.AddEdge(NewEdge(0, 2, 5))
+	G.AddEdge(NewEdge(1, 3, 5))
+	G.AddEdge(NewEdge(1, 2, 0.5))
+	hk := NewHeldKarpTSP(G)
+	assert.InDelta(3.5, hk.Weight(), 1e-9)
+	assertTour(assert, G, hk)
+	assert.InDelta(3.5, NewTwoOptTSP(G, []int{0, 2, 1, 3}).Weight(), 1e-9)
+	assert.InDelta(3.5, NewOrOptTSP(G, []int{0, 2, 1, 3}).Weight(), 1e-9)
+
+	// no Hamiltonian cycle in a star
+	star := NewEdgeWeightedGraphV(4)
+	for v := 1; v < 4; v++ {
+		star.AddEdge(NewEdge(0, v, 1))
+	}
+	assert.False(NewHeldKarpTSP(star).HasTour())
+	assert.Nil(NewHeldKarpTSP(star).Edges())
+	assertTour(assert, star, NewHeldKarpTSP(star))
+	assert.False(NewMSTApproxTSP(star).HasTour())
+
+	// tiny graphs
+	assert.Empty(NewHeldKarpTSP(NewEdgeWeightedGraphV(0)).Tour())
+	assert.Equal(0.0, NewHeldKarpTSP(NewEdgeWeightedGraphV(0)).Weight())
+	assert.Equal([]int{0}, NewNearestNeighborTSP(NewEdgeWeightedGraphV(1), 0).Tour())
+	assert.Equal(0.0, NewMSTApproxTSP(NewEdgeWeightedGraphV(1)).Weight())
+	pair := NewEdgeWeightedGraphV(2)
+	pair.AddEdge(NewEdge(0, 1, 3))
+	assert.Equal(6.0, NewHeldKarpTSP(pair).Weight())
+	assert.Len(NewHeldKarpTSP(pair).Edges(), 2)
+
+	assert.Panics(func() { NewHeldKarpTSP(NewEdgeWeightedGraphV(21)) })
+	assert.Panics(func() { NewTwoOptTSP(G, []int{0, 1, 2}) })
+	assert.Panics(func() { NewOrOptTSP(G, []int{0, 1, 1, 3}) })
+	assert.Panics(func() { NewNearestNeighborTSP(G, 4) })
+}
+
+func TestTSPRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(37))
+	for trial := 0; trial < 30; trial++ {
+		V := 3 + r.Intn(6)
+		G := euclideanTSPGraph(r, V)
+		optimum := bruteForceTSP(G)
+		hk := NewHeldKarpTSP(G)
+		assertTour(assert, G, hk)
+		assert.InDelta(optimum, hk.Weight(), 1e-9)
+
+		mst := NewMSTApproxTSP(G)
+		assertTour(assert, G, mst)
+		assert.True(mst.Weight() <= 2*optimum+1e-9)
+		nn := NewNearestNeighborTSP(G, r.Intn(V))
+		assertTour(assert, G, nn)
+		for _, start := range []*TSP{mst, nn} {
+			two := NewTwoOptTSP(G, start.Tour())
+			or := NewOrOptTSP(G, start.Tour())
+			assertTour(assert, G, two)
+			assertTour(assert, G, or)
+			assert.True(two.Weight() <= start.Weight()+1e-9)
+			assert.True(or.Weight() <= start.Weight()+1e-9)
+			assert.True(two.Weight() >= optimum-1e-9)
+			assert.True(or.Weight() >= optimum-1e-9)
+		}
+	}
+
+	// sparse graphs: Held-Karp finds a tour exactly when brute force does
+	for trial := 0; trial < 30; trial++ {
+		V := 3 + r.Intn(6)
+		G := NewEdgeWeightedGraphV(V)
+		for i := 0; i < 2*V; i++ {
+			G.AddEdge(NewEdge(r.Intn(V), r.Intn(V), float64(1+r.Intn(9))))
+		}
+		optimum := bruteForceTSP(G)
+		hk := NewHeldKarpTSP(G)
+		assertTour(assert, G, hk)
+		assert.Equal(!math.IsInf(optimum, 1), hk.HasTour())
+		if hk.HasTour() {
+			assert.Equal(optimum, hk.Weight())
+		}
+	}
+
+	// local search on a larger instance
+	G := euclideanTSPGraph(r, 200)
+	nn := NewNearestNeighborTSP(G, 0)
+	improved := NewOrOptTSP(G, NewTwoOptTSP(G, nn.Tour()).Tour())
+	assertTour(assert, G, improved)
+	assert.True(improved.Weight() < nn.Weight())
+}
@@ -0,0 +1,23 @@
+package digraph
+
+import "github.com/handane123/algorithms/internal/wl"
+
+// WeisfeilerLehmanHash returns a hash of the digraph G that is the same for isomorphic digraphs, which makes
+// it a fast filter before an exact test with NewIsomorphism: digraphs with different hashes are not isomorphic,
+// though some nonisomorphic digraphs share a hash. Every vertex starts with the given label (the empty label
+// if label is nil); in each of the given number of iterations, it is relabeled with a digest of its label and
+// the sorted labels of its out-neighbors and of its in-neighbors, counted with multiplicity. The result is
+// a digest of the sorted labels of all iterations. Pass a label built from vertex names to hash labeled
+// digraphs, matching LabelsByName.
+// This implementation takes O(k (E + V) log V) time, where k is the number of iterations.
+func WeisfeilerLehmanHash(G *Digraph, iterations int, label func(v int) string) string {
+	out := make([][]int, G.V())
+	in := make([][]int, G.V())
+	for v := range out {
+		out[v] = G.Adj(v)
+		for _, w := range out[v] {
+			in[w] = append(in[w], v)
+		}
+	}
+	return wl.Hash(G.V(), [][][]int{out, in}, iterations, label)
+}
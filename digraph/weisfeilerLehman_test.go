@@ -0,0 +1,28 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeisfeilerLehmanHash(t *testing.T) {
+	assert := assert.New(t)
+
+	// a path and the same path with the last edge reversed have the same underlying graph
+	forward := NewDigraph(3)
+	forward.AddEdge(0, 1)
+	forward.AddEdge(1, 2)
+	converging := NewDigraph(3)
+	converging.AddEdge(0, 1)
+	converging.AddEdge(2, 1)
+	assert.NotEqual(WeisfeilerLehmanHash(forward, 1, nil), WeisfeilerLehmanHash(converging, 1, nil))
+	assert.Equal(WeisfeilerLehmanHash(forward, 0, nil), WeisfeilerLehmanHash(converging, 0, nil))
+	assert.Equal(WeisfeilerLehmanHash(forward, 2, nil), WeisfeilerLehmanHash(forward.Reverse().Reverse(), 2, nil))
+
+	labels := func(v int) string { return []string{"x", "y", "x"}[v] }
+	assert.NotEqual(WeisfeilerLehmanHash(forward, 0, labels), WeisfeilerLehmanHash(forward, 0, nil))
+
+	assert.Equal(64, len(WeisfeilerLehmanHash(NewDigraph(0), 2, nil)))
+	assert.Panics(func() { WeisfeilerLehmanHash(forward, -1, nil) })
+}
@@ -0,0 +1,212 @@
+package geometry
+
+import (
+	"math"
+	"sort"
+)
+
+// KdTree struct represents a static set of points in the plane organized as a 2d-tree,
+// supporting nearest-neighbor queries, optionally restricted to a cone of directions.
+// This implementation builds a balanced tree by splitting on the median, alternating between
+// the x- and y-coordinates, and keeps the bounding box of every subtree for pruning.
+// The constructor takes O(n log² n) time, where n is the number of points.
+// A nearest-neighbor query takes O(log n) time for typical inputs and O(n) time in the worst case.
+// It uses O(n) extra space (not including the points).
+type KdTree struct {
+	points []*Point2D
+	root   *kdNode
+}
+
+type kdNode struct {
+	i           int     // index of the point stored in this node
+	vertical    bool    // split on x-coordinate?
+	left, right *kdNode // subtrees below/left and above/right of the split
+	xmin, ymin  float64 // bounding box of the points in this subtree
+	xmax, ymax  float64
+}
+
+// NewKdTree builds a 2d-tree over the given points. The indices taken and returned by the
+// queries refer to positions in points; a point may appear more than once.
+func NewKdTree(points []*Point2D) *KdTree {
+	t := &KdTree{points: make([]*Point2D, len(points))}
+	copy(t.points, points)
+	index := make([]int, len(points))
+	for i, p := range t.points {
+		if p == nil {
+			panic("point is nil")
+		}
+		index[i] = i
+	}
+	t.root = t.build(index, true)
+	return t
+}
+
+func (t *KdTree) build(index []int, vertical bool) *kdNode {
+	if len(index) == 0 {
+		return nil
+	}
+	sort.Slice(index, func(a, b int) bool {
+		p, q := t.points[index[a]], t.points[index[b]]
+		if vertical {
+			return p.x < q.x
+		}
+		return p.y < q.y
+	})
+	mid := len(index) / 2
+	n := &kdNode{
+		i:        index[mid],
+		vertical: vertical,
+		xmin:     math.Inf(1),
+		ymin:     math.Inf(1),
+		xmax:     math.Inf(-1),
+		ymax:     math.Inf(-1),
+	}
+	for _, i := range index {
+		p := t.points[i]
+		n.xmin = math.Min(n.xmin, p.x)
+		n.ymin = math.Min(n.ymin, p.y)
+		n.xmax = math.Max(n.xmax, p.x)
+		n.ymax = math.Max(n.ymax, p.y)
+	}
+	n.left = t.build(index[:mid], !vertical)
+	n.right = t.build(index[mid+1:], !vertical)
+	return n
+}
+
+// Size returns the number of points in the tree.
+func (t *KdTree) Size() int {
+	return len(t.points)
+}
+
+// Point returns the i-th point.
+func (t *KdTree) Point(i int) *Point2D {
+	return t.points[i]
+}
+
+// Nearest returns the index of a point nearest to p, or -1 if the tree is empty. A point of the tree with
+// the same coordinates as p is at distance 0, and ties are broken arbitrarily.
+func (t *KdTree) Nearest(p *Point2D) int {
+	best, bestDist := -1, math.Inf(1)
+	t.nearest(t.root, p, -1, nil, &best, &bestDist)
+	return best
+}
+
+// NearestTo returns the index of a point nearest to the i-th point, other than the i-th point itself,
+// or -1 if there is no such point. Points are told apart by their index, so another point with the same
+// coordinates, even the same *Point2D given twice, is at distance 0.
+func (t *KdTree) NearestTo(i int) int {
+	best, bestDist := -1, math.Inf(1)
+	t.nearest(t.root, t.points[i], i, nil, &best, &bestDist)
+	return best
+}
+
+// NearestInCone returns the index of a point nearest to the i-th point p, other than the i-th point itself,
+// among the points q such that the angle of the vector q - p, measured counterclockwise from the positive
+// x-axis, lies in the half-open interval [lo, hi). The width hi - lo must be positive and at most π.
+// Other points with the same coordinates as p, told apart by their index as for NearestTo, belong to every
+// cone. It returns -1 if there is no such point.
+func (t *KdTree) NearestInCone(i int, lo, hi float64) int {
+	if !(hi > lo) || hi-lo > math.Pi {
+		panic("cone width must be positive and at most π")
+	}
+	c := &cone{lo: lo, width: hi - lo, x1: math.Cos(lo), y1: math.Sin(lo), x2: math.Cos(hi), y2: math.Sin(hi)}
+	best, bestDist := -1, math.Inf(1)
+	t.nearest(t.root, t.points[i], i, c, &best, &bestDist)
+	return best
+}
+
+// searches the subtree of n for a point nearest to p other than the point with index exclude
+func (t *KdTree) nearest(n *kdNode, p *Point2D, exclude int, c *cone, best *int, bestDist *float64) {
+	if n == nil || n.distanceSquaredTo(p) > *bestDist {
+		return
+	}
+	if c != nil && c.disjoint(p, n) {
+		return
+	}
+	if n.i != exclude {
+		q := t.points[n.i]
+		if d := p.DistanceSquaredTo(q); d < *bestDist && (c == nil || c.contains(p, q)) {
+			*best, *bestDist = n.i, d
+		}
+	}
+	// visit the side containing p first
+	first, second := n.left, n.right
+	if (n.vertical && p.x >= t.points[n.i].x) || (!n.vertical && p.y >= t.points[n.i].y) {
+		first, second = second, first
+	}
+	t.nearest(first, p, exclude, c, best, bestDist)
+	t.nearest(second, p, exclude, c, best, bestDist)
+}
+
+// returns the square of the distance from p to the bounding box of the subtree
+func (n *kdNode) distanceSquaredTo(p *Point2D) float64 {
+	dx, dy := 0.0, 0.0
+	if p.x < n.xmin {
+		dx = p.x - n.xmin
+	} else if p.x > n.xmax {
+		dx = p.x - n.xmax
+	}
+	if p.y < n.ymin {
+		dy = p.y - n.ymin
+	} else if p.y > n.ymax {
+		dy = p.y - n.ymax
+	}
+	return dx*dx + dy*dy
+}
+
+// a cone of directions [lo, lo + width) with boundary unit vectors (x1, y1) and (x2, y2)
+type cone struct {
+	lo, width      float64
+	x1, y1, x2, y2 float64
+}
+
+func (c *cone) contains(p, q *Point2D) bool {
+	if p.Equals(q) {
+		return true
+	}
+	theta := math.Atan2(q.y-p.y, q.x-p.x) - c.lo
+	theta = math.Mod(theta, 2*math.Pi)
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return theta < c.width
+}
+
+// returns true if the bounding box of n certainly does not meet the closed cone with apex p,
+// using the separating axis theorem on the two cone boundaries and the two coordinate axes
+func (c *cone) disjoint(p *Point2D, n *kdNode) bool {
+	xs := [4]float64{n.xmin, n.xmin, n.xmax, n.xmax}
+	ys := [4]float64{n.ymin, n.ymax, n.ymin, n.ymax}
+	// tolerate rounding in the boundary vectors so that points on a boundary are never pruned
+	const eps = 1e-12
+	right1, left2 := true, true
+	for k := range xs {
+		dx, dy := xs[k]-p.x, ys[k]-p.y
+		tol := eps * (math.Abs(dx) + math.Abs(dy))
+		if c.x1*dy-c.y1*dx >= -tol {
+			right1 = false
+		}
+		if c.x2*dy-c.y2*dx <= tol {
+			left2 = false
+		}
+	}
+	if right1 || left2 {
+		return true
+	}
+	if c.width >= math.Pi {
+		return false
+	}
+	if math.Min(c.x1, c.x2) >= -eps && n.xmax < p.x {
+		return true
+	}
+	if math.Max(c.x1, c.x2) <= eps && n.xmin > p.x {
+		return true
+	}
+	if math.Min(c.y1, c.y2) >= -eps && n.ymax < p.y {
+		return true
+	}
+	if math.Max(c.y1, c.y2) <= eps && n.ymin > p.y {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKdTree_Nearest(t *testing.T) {
+	assert := assert.New(t)
+
+	points := []*Point2D{
+		NewPoint2D(0.7, 0.2),
+		NewPoint2D(0.5, 0.4),
+		NewPoint2D(0.2, 0.3),
+		NewPoint2D(0.4, 0.7),
+		NewPoint2D(0.9, 0.6),
+	}
+	tree := NewKdTree(points)
+	assert.Equal(5, tree.Size())
+	assert.Equal(points[3], tree.Point(3))
+	assert.Equal(1, tree.Nearest(NewPoint2D(0.5, 0.5)))
+	assert.Equal(4, tree.Nearest(NewPoint2D(1.0, 1.0)))
+	assert.Equal(0, tree.Nearest(NewPoint2D(0.7, 0.2)))
+	// a point of the tree is not its own nearest neighbor
+	assert.Equal(1, tree.NearestTo(0))
+
+	// nearest point above (0.5, 0.4)
+	assert.Equal(3, tree.NearestInCone(1, math.Pi/4, 3*math.Pi/4))
+	// nothing below-left of (0.2, 0.3)
+	assert.Equal(-1, tree.NearestInCone(2, -math.Pi, -math.Pi/2))
+
+	// a point given twice is the nearest neighbor of its other copy
+	twice := NewKdTree(append(points, points[2]))
+	assert.Equal(5, twice.NearestTo(2))
+	assert.Equal(2, twice.NearestTo(5))
+	assert.Equal(5, twice.NearestInCone(2, 0, 1))
+
+	assert.Equal(-1, NewKdTree(nil).Nearest(points[0]))
+	assert.Equal(-1, NewKdTree(points[:1]).NearestTo(0))
+	assert.Panics(func() { tree.NearestInCone(0, 1, 1) })
+	assert.Panics(func() { tree.NearestInCone(0, 0, 4) })
+	assert.Panics(func() { NewKdTree([]*Point2D{nil}) })
+}
+
+func TestKdTree_NearestInCone(t *testing.T) {
+	assert := assert.New(t)
+
+	r := rand.New(rand.NewSource(7))
+	points := make([]*Point2D, 300)
+	for i := range points {
+		// coarse coordinates so that there are coincident and collinear points
+		points[i] = NewPoint2D(float64(r.Intn(40)), float64(r.Intn(40)))
+	}
+	// and the same points given more than once
+	for i := 0; i < 20; i++ {
+		points = append(points, points[r.Intn(len(points))])
+	}
+	tree := NewKdTree(points)
+	cones := 8
+	width := 2 * math.Pi / float64(cones)
+	for v, p := range points {
+		nearest := math.Inf(1)
+		for w, q := range points {
+			if w != v {
+				nearest = math.Min(nearest, p.DistanceSquaredTo(q))
+			}
+		}
+		assert.Equal(nearest, p.DistanceSquaredTo(points[tree.NearestTo(v)]))
+		assert.Equal(0.0, p.DistanceSquaredTo(points[tree.Nearest(p)]))
+
+		for k := 0; k < cones; k++ {
+			lo := -math.Pi + float64(k)*width
+			c := &cone{lo: lo, width: width}
+			best := math.Inf(1)
+			for w, q := range points {
+				if w != v && c.contains(p, q) {
+					best = math.Min(best, p.DistanceSquaredTo(q))
+				}
+			}
+			i := tree.NearestInCone(v, lo, lo+width)
+			if math.IsInf(best, 1) {
+				assert.Equal(-1, i)
+			} else {
+				assert.Equal(best, p.DistanceSquaredTo(points[i]))
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+)
+
+// Point2D struct represents an immutable point in the plane with real-valued coordinates.
+type Point2D struct {
+	x float64 // x coordinate
+	y float64 // y coordinate
+}
+
+// NewPoint2D initializes a new point (x, y).
+func NewPoint2D(x, y float64) *Point2D {
+	if math.IsInf(x, 0) || math.IsInf(y, 0) {
+		panic("coordinates must be finite")
+	}
+	if math.IsNaN(x) || math.IsNaN(y) {
+		panic("coordinate cannot be NaN")
+	}
+	// convert -0.0 to +0.0
+	if x == 0.0 {
+		x = 0.0
+	}
+	if y == 0.0 {
+		y = 0.0
+	}
+	return &Point2D{x: x, y: y}
+}
+
+// X returns the x-coordinate of this point.
+func (p *Point2D) X() float64 {
+	return p.x
+}
+
+// Y returns the y-coordinate of this point.
+func (p *Point2D) Y() float64 {
+	return p.y
+}
+
+// Theta returns the angle of this point in polar coordinates, between -π and π.
+func (p *Point2D) Theta() float64 {
+	return math.Atan2(p.y, p.x)
+}
+
+// DistanceTo returns the Euclidean distance between this point and that point.
+func (p *Point2D) DistanceTo(that *Point2D) float64 {
+	return math.Sqrt(p.DistanceSquaredTo(that))
+}
+
+// DistanceSquaredTo returns the square of the Euclidean distance between this point and that point.
+func (p *Point2D) DistanceSquaredTo(that *Point2D) float64 {
+	dx := p.x - that.x
+	dy := p.y - that.y
+	return dx*dx + dy*dy
+}
+
+// Equals returns true if this point has the same coordinates as that point.
+func (p *Point2D) Equals(that *Point2D) bool {
+	return p.x == that.x && p.y == that.y
+}
+
+// CompareTo compares two points by y-coordinate, breaking ties by x-coordinate.
+func (p *Point2D) CompareTo(that *Point2D) int {
+	if p.y < that.y {
+		return -1
+	}
+	if p.y > that.y {
+		return 1
+	}
+	if p.x < that.x {
+		return -1
+	}
+	if p.x > that.x {
+		return 1
+	}
+	return 0
+}
+
+// String returns a string representation of this point.
+func (p *Point2D) String() string {
+	return fmt.Sprintf("(%v, %v)", p.x, p.y)
+}
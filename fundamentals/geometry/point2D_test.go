@@ -0,0 +1,30 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoint2D(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Panics(func() { NewPoint2D(math.Inf(1), 0) })
+	assert.Panics(func() { NewPoint2D(0, math.NaN()) })
+
+	p := NewPoint2D(3, 4)
+	q := NewPoint2D(0, 0)
+	assert.Equal(3.0, p.X())
+	assert.Equal(4.0, p.Y())
+	assert.Equal(5.0, p.DistanceTo(q))
+	assert.Equal(25.0, p.DistanceSquaredTo(q))
+	assert.InEpsilon(math.Atan2(4, 3), p.Theta(), 1e-12)
+	assert.Equal("(3, 4)", p.String())
+
+	assert.True(NewPoint2D(-0.0, 0).Equals(q))
+	assert.Equal(1, p.CompareTo(q))
+	assert.Equal(-1, q.CompareTo(p))
+	assert.Equal(-1, NewPoint2D(1, 4).CompareTo(p))
+	assert.Equal(0, p.CompareTo(NewPoint2D(3, 4)))
+}
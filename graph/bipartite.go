@@ -20,7 +20,7 @@ type Bipartite struct {
 }
 
 // NewBipartite determines whether an undirected graph is bipartite and finds either a bipartition or an odd-length cycle.
-func NewBipartite(G *Graph) *Bipartite {
+func NewBipartite(G GraphView) *Bipartite {
 	b := &Bipartite{
 		isBipartite: true,
 		color:       make([]bool, G.V()),
@@ -36,7 +36,7 @@ func NewBipartite(G *Graph) *Bipartite {
 	return b
 }
 
-func (b *Bipartite) dfs(G *Graph, v int) {
+func (b *Bipartite) dfs(G GraphView, v int) {
 	b.marked[v] = true
 	for _, w := range G.Adj(v) {
 		if b.cycle != nil {
@@ -23,7 +23,7 @@ type BreadthFirstPaths struct {
 }
 
 // NewBreadthFirstPaths computes the shortest path between the source vertex s and every other vertex in the graph G.
-func NewBreadthFirstPaths(G *Graph, s int) *BreadthFirstPaths {
+func NewBreadthFirstPaths(G GraphView, s int) *BreadthFirstPaths {
 	bfp := &BreadthFirstPaths{
 		marked: make([]bool, G.V()),
 		edgeTo: make([]int, G.V()),
@@ -34,7 +34,7 @@ func NewBreadthFirstPaths(G *Graph, s int) *BreadthFirstPaths {
 	return bfp
 }
 
-func (bfp *BreadthFirstPaths) bfs(G *Graph, s int) {
+func (bfp *BreadthFirstPaths) bfs(G GraphView, s int) {
 	q := arrayqueue.New()
 	for v := 0; v < G.V(); v++ {
 		bfp.distTo[v] = infinity
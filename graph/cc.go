@@ -14,7 +14,7 @@ type CC struct {
 }
 
 // NewCC computes the connected components of the undirected graph G
-func NewCC(G *Graph) *CC {
+func NewCC(G GraphView) *CC {
 	cc := &CC{
 		marked: make([]bool, G.V()),
 		id:     make([]int, G.V()),
@@ -29,7 +29,7 @@ func NewCC(G *Graph) *CC {
 	return cc
 }
 
-func (cc *CC) dfs(G *Graph, v int) {
+func (cc *CC) dfs(G GraphView, v int) {
 	cc.marked[v] = true
 	cc.id[v] = cc.count
 	cc.size[cc.count]++
@@ -0,0 +1,176 @@
+package graph
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Centrality struct represents a data type for ranking the vertices of an undirected graph
+// by betweenness, closeness and harmonic centrality.
+// The betweenness of v is the sum, over all pairs of vertices s and t other than v, of the fraction of
+// shortest s-t paths that pass through v; each unordered pair is counted once.
+// The closeness of v is (r - 1) / d, where r is the number of vertices reachable from v (including v)
+// and d is the sum of their distances from v; it is 0 if v reaches no other vertex.
+// The harmonic centrality of v is the sum of 1 / dist(v, w) over all vertices w != v reachable from v.
+// This implementation uses Brandes' algorithm with a breadth-first search from every vertex.
+// The sources can be divided among several goroutines.
+// The constructor takes O(V E) time in the worst case, where V is the number of vertices
+// and E is the number of edges. Each instance method takes O(1) time.
+// It uses O(V + E) extra space per goroutine (not including the graph).
+type Centrality struct {
+	betweenness []float64
+	closeness   []float64
+	harmonic    []float64
+}
+
+// NewCentrality computes the centrality of every vertex in the graph G, running the breadth-first
+// searches on the given number of goroutines; workers less than 2 runs them sequentially.
+func NewCentrality(G *Graph, workers int) *Centrality {
+	c := &Centrality{
+		betweenness: make([]float64, G.V()),
+		closeness:   make([]float64, G.V()),
+		harmonic:    make([]float64, G.V()),
+	}
+	adj := make([][]int, G.V())
+	for v := 0; v < G.V(); v++ {
+		adj[v] = G.Adj(v)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > G.V() && G.V() > 0 {
+		workers = G.V()
+	}
+
+	// worker k handles the sources k, k + workers, k + 2 workers, ...
+	partial := make([][]float64, workers)
+	var wg sync.WaitGroup
+	for k := 0; k < workers; k++ {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			b := newBrandes(len(adj))
+			for s := k; s < len(adj); s += workers {
+				b.bfs(adj, s)
+				c.closeness[s], c.harmonic[s] = b.distances()
+				b.accumulate(s)
+			}
+			partial[k] = b.betweenness
+		}(k)
+	}
+	wg.Wait()
+
+	// sum in a fixed order so that the result does not depend on scheduling
+	for _, p := range partial {
+		for v := range p {
+			c.betweenness[v] += p[v]
+		}
+	}
+	// each unordered pair was counted from both ends
+	for v := range c.betweenness {
+		c.betweenness[v] /= 2
+	}
+	return c
+}
+
+// Betweenness returns the betweenness centrality of vertex v.
+func (c *Centrality) Betweenness(v int) float64 {
+	c.validateVertex(v)
+	return c.betweenness[v]
+}
+
+// Closeness returns the closeness centrality of vertex v.
+func (c *Centrality) Closeness(v int) float64 {
+	c.validateVertex(v)
+	return c.closeness[v]
+}
+
+// Harmonic returns the harmonic centrality of vertex v.
+func (c *Centrality) Harmonic(v int) float64 {
+	c.validateVertex(v)
+	return c.harmonic[v]
+}
+
+func (c *Centrality) validateVertex(v int) {
+	length := len(c.betweenness)
+	if v < 0 || v >= length {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", length-1))
+	}
+}
+
+// brandes holds the per-source state of Brandes' algorithm, reused across sources.
+type brandes struct {
+	order       []int     // vertices in nondecreasing order of distance from the source
+	preds       [][]int   // preds[w] = predecessors of w on shortest paths from the source
+	sigma       []float64 // sigma[w] = number of shortest paths from the source to w
+	dist        []int     // dist[w] = distance from the source to w, -1 if unreachable
+	delta       []float64 // delta[w] = dependency of the source on w
+	betweenness []float64 // betweenness accumulated over the sources handled so far
+}
+
+func newBrandes(V int) *brandes {
+	b := &brandes{
+		preds:       make([][]int, V),
+		sigma:       make([]float64, V),
+		dist:        make([]int, V),
+		delta:       make([]float64, V),
+		betweenness: make([]float64, V),
+	}
+	for v := range b.dist {
+		b.dist[v] = -1
+	}
+	return b
+}
+
+// single-source shortest paths from s, counting the shortest paths to every vertex
+func (b *brandes) bfs(adj [][]int, s int) {
+	for _, v := range b.order {
+		b.preds[v] = b.preds[v][:0]
+		b.sigma[v] = 0
+		b.dist[v] = -1
+		b.delta[v] = 0
+	}
+	b.order = append(b.order[:0], s)
+	b.sigma[s] = 1
+	b.dist[s] = 0
+	// b.order doubles as the queue
+	for head := 0; head < len(b.order); head++ {
+		v := b.order[head]
+		for _, w := range adj[v] {
+			if b.dist[w] < 0 {
+				b.dist[w] = b.dist[v] + 1
+				b.order = append(b.order, w)
+			}
+			if b.dist[w] == b.dist[v]+1 {
+				b.sigma[w] += b.sigma[v]
+				b.preds[w] = append(b.preds[w], v)
+			}
+		}
+	}
+}
+
+// returns the closeness and harmonic centrality of the last source
+func (b *brandes) distances() (closeness, harmonic float64) {
+	total := 0
+	for _, v := range b.order[1:] {
+		total += b.dist[v]
+		harmonic += 1.0 / float64(b.dist[v])
+	}
+	if total > 0 {
+		closeness = float64(len(b.order)-1) / float64(total)
+	}
+	return closeness, harmonic
+}
+
+// back-propagates the dependencies of the last source s in order of nonincreasing distance
+func (b *brandes) accumulate(s int) {
+	for i := len(b.order) - 1; i >= 0; i-- {
+		w := b.order[i]
+		for _, v := range b.preds[w] {
+			b.delta[v] += b.sigma[v] / b.sigma[w] * (1 + b.delta[w])
+		}
+		if w != s {
+			b.betweenness[w] += b.delta[w]
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCentrality(t *testing.T) {
+	assert := assert.New(t)
+
+	// path 0-1-2-3 and the isolated vertex 4
+	g := NewGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	c := NewCentrality(g, 1)
+	assert.Equal(0.0, c.Betweenness(0))
+	assert.Equal(2.0, c.Betweenness(1))
+	assert.Equal(2.0, c.Betweenness(2))
+	assert.Equal(0.0, c.Betweenness(4))
+	assert.Equal(0.5, c.Closeness(0))
+	assert.Equal(0.75, c.Closeness(1))
+	assert.Equal(0.0, c.Closeness(4))
+	assert.InEpsilon(1.0+1.0/2+1.0/3, c.Harmonic(0), 1e-12)
+	assert.Equal(2.5, c.Harmonic(1))
+	assert.Equal(0.0, c.Harmonic(4))
+	assert.Panics(func() { c.Betweenness(5) })
+
+	// every vertex of a 4-cycle lies on one of the two shortest paths between its neighbors
+	cycle := NewGraphGenerator().CycleGraph(4)
+	c = NewCentrality(cycle, 0)
+	for v := 0; v < cycle.V(); v++ {
+		assert.Equal(0.5, c.Betweenness(v))
+	}
+}
+
+func TestCentrality_Parallel(t *testing.T) {
+	assert := assert.New(t)
+
+	g, err := NewGraphGenerator().Simple(60, 150)
+	assert.Nil(err)
+	seq := NewCentrality(g, 1)
+	par := NewCentrality(g, 4)
+	for v := 0; v < g.V(); v++ {
+		assert.InDelta(seq.Betweenness(v), par.Betweenness(v), 1e-9)
+		assert.Equal(seq.Closeness(v), par.Closeness(v))
+		assert.Equal(seq.Harmonic(v), par.Harmonic(v))
+	}
+	assert.NotPanics(func() { NewCentrality(NewGraph(0), 8) })
+}
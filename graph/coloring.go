@@ -0,0 +1,333 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Ordering selects the order in which greedy coloring visits the vertices.
+type Ordering int
+
+const (
+	// NaturalOrder visits the vertices in ascending order of index.
+	NaturalOrder Ordering = iota
+	// LargestFirstOrder visits the vertices in descending order of degree (Welsh–Powell).
+	LargestFirstOrder
+	// SmallestLastOrder visits the vertices in reverse degeneracy order, which uses at most
+	// one more color than the degeneracy of the graph.
+	SmallestLastOrder
+)
+
+// Coloring struct represents a data type for coloring the vertices of an undirected graph so that
+// adjacent vertices get different colors. The colors are numbered 0 through Count() - 1.
+// A graph with a self-loop has no such coloring, so the constructors panic on one.
+// Parallel edges are treated as a single edge.
+// Greedy coloring visits the vertices in a given order and assigns each the smallest color not used by its
+// neighbors; it takes O(E + V log V) time.
+// DSatur (Brélaz) repeatedly colors the uncolored vertex whose neighbors use the most distinct colors,
+// breaking ties by degree; it takes O(V^2 + E) time.
+// Exact coloring uses the chromatic number of colors, the fewest possible. It is computed by branch and bound
+// over DSatur-ordered assignments, starting from a DSatur coloring and a maximum clique as upper and lower
+// bounds; it takes exponential time in the worst case and is intended for graphs of up to about 50 vertices.
+// Each instance method takes O(1) time. It uses O(E + V) extra space (not including the graph).
+type Coloring struct {
+	color []int // color[v] = color of vertex v
+	count int   // number of colors used
+}
+
+// NewGreedyColoring colors the graph G greedily, visiting the vertices in the given ordering.
+func NewGreedyColoring(G *Graph, ordering Ordering) *Coloring {
+	order := make([]int, G.V())
+	switch ordering {
+	case NaturalOrder:
+		for v := range order {
+			order[v] = v
+		}
+	case LargestFirstOrder:
+		for v := range order {
+			order[v] = v
+		}
+		sort.SliceStable(order, func(i, j int) bool { return G.Degree(order[i]) > G.Degree(order[j]) })
+	case SmallestLastOrder:
+		degeneracy := NewCoreDecomposition(G).Order()
+		for i, v := range degeneracy {
+			order[len(order)-1-i] = v
+		}
+	default:
+		panic("unknown ordering")
+	}
+	return NewGreedyColoringOrder(G, order)
+}
+
+// NewGreedyColoringOrder colors the graph G greedily, visiting the vertices in the given order,
+// which must contain every vertex exactly once.
+func NewGreedyColoringOrder(G *Graph, order []int) *Coloring {
+	if len(order) != G.V() {
+		panic("order must contain every vertex exactly once")
+	}
+	seen := make([]bool, G.V())
+	for _, v := range order {
+		G.validateVertex(v)
+		if seen[v] {
+			panic("order must contain every vertex exactly once")
+		}
+		seen[v] = true
+	}
+	adj := looplessNeighbors(G)
+	c := newColoring(G.V())
+
+	// used[k] == v means color k is taken by a neighbor of v
+	used := make([]int, G.V()+1)
+	for k := range used {
+		used[k] = -1
+	}
+	for _, v := range order {
+		for _, w := range adj[v] {
+			if c.color[w] >= 0 {
+				used[c.color[w]] = v
+			}
+		}
+		k := 0
+		for used[k] == v {
+			k++
+		}
+		c.assign(v, k)
+	}
+	return c
+}
+
+// NewDSaturColoring colors the graph G with the DSatur heuristic.
+func NewDSaturColoring(G *Graph) *Coloring {
+	adj := looplessNeighbors(G)
+	c := newColoring(G.V())
+	s := newSaturation(adj)
+	for colored := 0; colored < G.V(); colored++ {
+		v := s.next(c.color)
+		k := 0
+		for s.blocked(v, k) {
+			k++
+		}
+		c.assign(v, k)
+		s.add(v, k)
+	}
+	return c
+}
+
+// NewExactColoring colors the graph G with the fewest colors possible.
+func NewExactColoring(G *Graph) *Coloring {
+	adj := looplessNeighbors(G)
+	best := NewDSaturColoring(G)
+
+	// a maximum clique needs one color per vertex, and fixing its colors breaks the symmetry between colors
+	var clique []int
+	MaximalCliques(G, func(q []int) bool {
+		if len(q) > len(clique) {
+			clique = append(clique[:0], q...)
+		}
+		return len(clique) < best.count
+	})
+	if len(clique) == best.count {
+		return best
+	}
+
+	ec := &exactColoring{
+		adj:        adj,
+		current:    newColoring(G.V()),
+		saturation: newSaturation(adj),
+		best:       best,
+		lower:      len(clique),
+	}
+	for k, v := range clique {
+		ec.current.assign(v, k)
+		ec.saturation.add(v, k)
+	}
+	ec.search(len(clique))
+	return ec.best
+}
+
+// exactColoring holds the state of the branch-and-bound search for a minimum coloring.
+type exactColoring struct {
+	adj        [][]int
+	current    *Coloring   // partial coloring being extended
+	saturation *saturation // saturation of the partial coloring
+	best       *Coloring   // best complete coloring found so far
+	lower      int         // lower bound on the number of colors
+}
+
+// extends the current coloring of colored vertices; returns true once a coloring meeting the lower bound is found
+func (ec *exactColoring) search(colored int) bool {
+	if colored == len(ec.adj) {
+		ec.best = &Coloring{color: append([]int(nil), ec.current.color...), count: ec.current.count}
+		return ec.best.count == ec.lower
+	}
+	used := ec.current.count
+	if used >= ec.best.count {
+		return false
+	}
+	v := ec.saturation.next(ec.current.color)
+	for k := 0; k <= used && k < ec.best.count-1; k++ {
+		if ec.saturation.blocked(v, k) {
+			continue
+		}
+		ec.current.assign(v, k)
+		ec.saturation.add(v, k)
+		if ec.search(colored + 1) {
+			return true
+		}
+		ec.saturation.remove(v, k)
+		ec.current.color[v] = -1
+		ec.current.count = used
+	}
+	return false
+}
+
+func newColoring(V int) *Coloring {
+	c := &Coloring{color: make([]int, V)}
+	for v := range c.color {
+		c.color[v] = -1
+	}
+	return c
+}
+
+func (c *Coloring) assign(v, k int) {
+	c.color[v] = k
+	if k >= c.count {
+		c.count = k + 1
+	}
+}
+
+// saturation tracks, for every vertex, how many of its neighbors have each color.
+type saturation struct {
+	adj      [][]int
+	counts   []map[int]int // counts[v][k] = number of neighbors of v with color k
+	distinct []int         // distinct[v] = number of distinct colors among the neighbors of v
+}
+
+func newSaturation(adj [][]int) *saturation {
+	s := &saturation{adj: adj, counts: make([]map[int]int, len(adj)), distinct: make([]int, len(adj))}
+	for v := range s.counts {
+		s.counts[v] = make(map[int]int)
+	}
+	return s
+}
+
+// returns the uncolored vertex of largest saturation, breaking ties by degree and then by index
+func (s *saturation) next(color []int) int {
+	best := -1
+	for v := range s.adj {
+		if color[v] >= 0 {
+			continue
+		}
+		if best < 0 || s.distinct[v] > s.distinct[best] ||
+			s.distinct[v] == s.distinct[best] && len(s.adj[v]) > len(s.adj[best]) {
+			best = v
+		}
+	}
+	return best
+}
+
+// is color k used by a neighbor of v?
+func (s *saturation) blocked(v, k int) bool {
+	return s.counts[v][k] > 0
+}
+
+// records that v has color k
+func (s *saturation) add(v, k int) {
+	for _, w := range s.adj[v] {
+		if s.counts[w][k] == 0 {
+			s.distinct[w]++
+		}
+		s.counts[w][k]++
+	}
+}
+
+// records that v no longer has color k
+func (s *saturation) remove(v, k int) {
+	for _, w := range s.adj[v] {
+		s.counts[w][k]--
+		if s.counts[w][k] == 0 {
+			delete(s.counts[w], k)
+			s.distinct[w]--
+		}
+	}
+}
+
+// returns the distinct neighbors of every vertex in ascending order, panicking on a self-loop
+func looplessNeighbors(G *Graph) [][]int {
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			if w == v {
+				panic(fmt.Sprintln("vertex ", v, " has a self-loop"))
+			}
+		}
+	}
+	return distinctNeighbors(G)
+}
+
+// returns the distinct neighbors of every vertex other than itself, in ascending order
+func distinctNeighbors(G *Graph) [][]int {
+	adj := make([][]int, G.V())
+	for v := range adj {
+		neighbors := G.Adj(v)
+		sort.Ints(neighbors)
+		for i, w := range neighbors {
+			if w != v && (i == 0 || w != neighbors[i-1]) {
+				adj[v] = append(adj[v], w)
+			}
+		}
+	}
+	return adj
+}
+
+// IsProperColoring returns true if color assigns a nonnegative color to every vertex of the graph G
+// and no edge joins two vertices of the same color.
+func IsProperColoring(G *Graph, color []int) bool {
+	if len(color) != G.V() {
+		return false
+	}
+	for v := 0; v < G.V(); v++ {
+		if color[v] < 0 {
+			return false
+		}
+		for _, w := range G.Adj(v) {
+			if color[w] == color[v] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Count returns the number of colors used.
+func (c *Coloring) Count() int {
+	return c.count
+}
+
+// Color returns the color of vertex v.
+func (c *Coloring) Color(v int) int {
+	c.validateVertex(v)
+	return c.color[v]
+}
+
+// Colors returns the color of every vertex.
+func (c *Coloring) Colors() (colors []int) {
+	colors = make([]int, len(c.color))
+	copy(colors, c.color)
+	return colors
+}
+
+// Classes returns the vertices of every color, indexed by color.
+func (c *Coloring) Classes() (classes [][]int) {
+	classes = make([][]int, c.count)
+	for v, k := range c.color {
+		classes[k] = append(classes[k], v)
+	}
+	return classes
+}
+
+func (c *Coloring) validateVertex(v int) {
+	V := len(c.color)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
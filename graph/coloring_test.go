@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColoring(t *testing.T) {
+	assert := assert.New(t)
+
+	// the Petersen graph has chromatic number 3 and no triangle
+	g := NewGraph(10)
+	for i := 0; i < 5; i++ {
+		g.AddEdge(i, (i+1)%5)
+		g.AddEdge(i, i+5)
+		g.AddEdge(i+5, (i+2)%5+5)
+	}
+	for _, ordering := range []Ordering{NaturalOrder, LargestFirstOrder, SmallestLastOrder} {
+		c := NewGreedyColoring(g, ordering)
+		assert.True(IsProperColoring(g, c.Colors()))
+		assert.LessOrEqual(c.Count(), 4)
+	}
+	dsatur := NewDSaturColoring(g)
+	assert.True(IsProperColoring(g, dsatur.Colors()))
+	exact := NewExactColoring(g)
+	assert.True(IsProperColoring(g, exact.Colors()))
+	assert.Equal(3, exact.Count())
+
+	classes := exact.Classes()
+	assert.Equal(3, len(classes))
+	for k, class := range classes {
+		for _, v := range class {
+			assert.Equal(k, exact.Color(v))
+		}
+	}
+
+	// the Grötzsch graph has chromatic number 4 but no triangle
+	mycielski := NewGraph(11)
+	for i := 0; i < 5; i++ {
+		mycielski.AddEdge(i, (i+1)%5)
+		mycielski.AddEdge(i+5, (i+1)%5)
+		mycielski.AddEdge(i+5, (i+4)%5)
+		mycielski.AddEdge(i+5, 10)
+	}
+	assert.Equal(4, NewExactColoring(mycielski).Count())
+
+	// greedy coloring in a bad order uses more colors than needed
+	crown := NewGraph(6)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if i != j {
+				crown.AddEdge(2*i, 2*j+1)
+			}
+		}
+	}
+	assert.Equal(3, NewGreedyColoringOrder(crown, []int{0, 1, 2, 3, 4, 5}).Count())
+	assert.Equal(2, NewExactColoring(crown).Count())
+
+	assert.False(IsProperColoring(g, make([]int, 10)))
+	assert.False(IsProperColoring(g, make([]int, 9)))
+	assert.Equal(0, NewExactColoring(NewGraph(0)).Count())
+	assert.Panics(func() { NewGreedyColoringOrder(g, []int{0, 0, 1, 2, 3, 4, 5, 6, 7, 8}) })
+	assert.Panics(func() { exact.Color(10) })
+	loop := NewGraph(1)
+	loop.AddEdge(0, 0)
+	assert.Panics(func() { NewDSaturColoring(loop) })
+}
+
+func TestExactColoringRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 30; trial++ {
+		V := 8
+		g := NewGraph(V)
+		for v := 0; v < V; v++ {
+			for w := v + 1; w < V; w++ {
+				if r.Float64() < 0.5 {
+					g.AddEdge(v, w)
+				}
+			}
+		}
+		c := NewExactColoring(g)
+		assert.True(IsProperColoring(g, c.Colors()))
+		assert.Equal(bruteForceChromatic(g), c.Count())
+	}
+}
+
+// returns the chromatic number by trying every assignment of k colors for increasing k
+func bruteForceChromatic(G *Graph) int {
+	V := G.V()
+	color := make([]int, V)
+	for k := 1; ; k++ {
+		for i := range color {
+			color[i] = 0
+		}
+		for {
+			if IsProperColoring(G, color) {
+				return k
+			}
+			i := 0
+			for i < V && color[i] == k-1 {
+				color[i] = 0
+				i++
+			}
+			if i == V {
+				break
+			}
+			color[i]++
+		}
+	}
+}
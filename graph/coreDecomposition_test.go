@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoreDecomposition(t *testing.T) {
+	assert := assert.New(t)
+
+	// the 4-clique 0-1-2-3, the triangle 3-4-5, the path 5-6-7 and the isolated vertex 8
+	g := NewGraph(9)
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3},
+		{3, 4}, {4, 5}, {3, 5}, {5, 6}, {6, 7}} {
+		g.AddEdge(e[0], e[1])
+	}
+	cd := NewCoreDecomposition(g)
+	expected := []int{3, 3, 3, 3, 2, 2, 1, 1, 0}
+	for v, k := range expected {
+		assert.Equal(k, cd.Core(v))
+	}
+	assert.Equal(3, cd.Degeneracy())
+	assert.Equal([]int{0, 1, 2, 3}, cd.KCore(3))
+	assert.Equal([]int{0, 1, 2, 3, 4, 5}, cd.KCore(2))
+	assert.Equal(9, len(cd.KCore(0)))
+
+	// every vertex has at most Degeneracy() neighbors later in the order
+	order := cd.Order()
+	position := make([]int, g.V())
+	for i, v := range order {
+		position[v] = i
+	}
+	for v := 0; v < g.V(); v++ {
+		later := 0
+		for _, w := range g.Adj(v) {
+			if position[w] > position[v] {
+				later++
+			}
+		}
+		assert.LessOrEqual(later, cd.Degeneracy())
+	}
+
+	// parallel edges count with multiplicity
+	m := NewGraph(2)
+	m.AddEdge(0, 1)
+	m.AddEdge(0, 1)
+	assert.Equal(2, NewCoreDecomposition(m).Core(0))
+
+	assert.Equal(0, NewCoreDecomposition(NewGraph(0)).Degeneracy())
+	assert.Panics(func() { cd.Core(9) })
+}
@@ -14,7 +14,7 @@ type Cycle struct {
 }
 
 // NewCycle determines whether the undirected graph G has a cycle and, if so, finds such a cycle.
-func NewCycle(G *Graph) *Cycle {
+func NewCycle(G GraphView) *Cycle {
 	c := &Cycle{}
 	if c.hasSelfLoop(G) {
 		return c
@@ -48,7 +48,7 @@ func (c *Cycle) GetCycle() (cy []int) {
 	return cy
 }
 
-func (c *Cycle) dfs(G *Graph, parent, v int) {
+func (c *Cycle) dfs(G GraphView, parent, v int) {
 	c.marked[v] = true
 	for _, w := range G.Adj(v) {
 		// short circuit if cycle already found
@@ -69,7 +69,7 @@ func (c *Cycle) dfs(G *Graph, parent, v int) {
 	}
 }
 
-func (c *Cycle) hasParallelEdges(G *Graph) bool {
+func (c *Cycle) hasParallelEdges(G GraphView) bool {
 	c.marked = make([]bool, G.V())
 	for v := 0; v < G.V(); v++ {
 		// check for parallel edges incident to v
@@ -91,7 +91,7 @@ func (c *Cycle) hasParallelEdges(G *Graph) bool {
 	return false
 }
 
-func (c *Cycle) hasSelfLoop(G *Graph) bool {
+func (c *Cycle) hasSelfLoop(G GraphView) bool {
 	for v := 0; v < G.V(); v++ {
 		for _, w := range G.Adj(v) {
 			if v == w {
@@ -19,7 +19,7 @@ type DepthFirstPaths struct {
 }
 
 // NewDepthFirstPaths computes a path between s and every other vertex in graph G.
-func NewDepthFirstPaths(G *Graph, s int) *DepthFirstPaths {
+func NewDepthFirstPaths(G GraphView, s int) *DepthFirstPaths {
 	dfp := &DepthFirstPaths{
 		marked: make([]bool, G.V()),
 		edgeTo: make([]int, G.V()),
@@ -29,7 +29,7 @@ func NewDepthFirstPaths(G *Graph, s int) *DepthFirstPaths {
 	return dfp
 }
 
-func (dfp *DepthFirstPaths) dfs(G *Graph, v int) {
+func (dfp *DepthFirstPaths) dfs(G GraphView, v int) {
 	dfp.marked[v] = true
 	for _, w := range G.Adj(v) {
 		if !dfp.marked[w] {
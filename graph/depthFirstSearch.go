@@ -12,14 +12,14 @@ type DepthFirstSearch struct {
 }
 
 // NewDepthFirstSearch computes the vertices in graph G that are connected to the source vertex s.
-func NewDepthFirstSearch(G *Graph, s int) *DepthFirstSearch {
+func NewDepthFirstSearch(G GraphView, s int) *DepthFirstSearch {
 	search := &DepthFirstSearch{marked: make([]bool, G.V())}
 	search.validateVertex(s)
 	search.dfs(G, s)
 	return search
 }
 
-func (search *DepthFirstSearch) dfs(G *Graph, v int) {
+func (search *DepthFirstSearch) dfs(G GraphView, v int) {
 	search.count++
 	search.marked[v] = true
 	for _, w := range G.Adj(v) {
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/handane123/algorithms/internal/dot"
+)
+
+// DOTHighlight struct represents the parts of a graph to highlight when writing it in the DOT language of Graphviz:
+// vertices, paths and cycles, and cuts. The highlights come straight from the result types: paths and cycles of
+// vertices from PathTo, GetCycle, OddCycle or HamiltonianCycle.Cycle, and cuts from predicates on the vertices,
+// such as Bipartite.Color. Edges given by consecutive vertices match every parallel edge.
+// Highlighted vertices and edges are drawn in red, the vertices on one side of a cut are filled, and the
+// edges crossing the cut are drawn dashed in blue.
+// The zero value is not usable; a nil highlight highlights nothing.
+type DOTHighlight struct {
+	vertices map[int]bool
+	pairs    map[[2]int]bool // v-w for consecutive vertices of paths
+	inCut    func(v int) bool
+}
+
+// NewDOTHighlight initializes a highlight of nothing.
+func NewDOTHighlight() *DOTHighlight {
+	return &DOTHighlight{
+		vertices: make(map[int]bool),
+		pairs:    make(map[[2]int]bool),
+	}
+}
+
+// Vertices highlights the given vertices and returns the highlight.
+func (h *DOTHighlight) Vertices(vertices ...int) *DOTHighlight {
+	for _, v := range vertices {
+		h.vertices[v] = true
+	}
+	return h
+}
+
+// Path highlights the vertices of a path or cycle given as a sequence of vertices, such as DepthFirstPaths.PathTo
+// or Cycle.GetCycle, and the edges between consecutive ones, and returns the highlight.
+func (h *DOTHighlight) Path(vertices []int) *DOTHighlight {
+	h.Vertices(vertices...)
+	for i := 1; i < len(vertices); i++ {
+		h.pairs[[2]int{vertices[i-1], vertices[i]}] = true
+	}
+	return h
+}
+
+// Cut highlights the cut whose one side holds the vertices v for which inCut(v) is true, and returns the highlight.
+func (h *DOTHighlight) Cut(inCut func(v int) bool) *DOTHighlight {
+	h.inCut = inCut
+	return h
+}
+
+// returns the attributes of vertex v
+func (h *DOTHighlight) vertexAttributes(v int) (attributes []string) {
+	if h == nil {
+		return nil
+	}
+	if h.vertices[v] {
+		attributes = append(attributes, "color=red", "penwidth=2")
+	}
+	if h.inCut != nil && h.inCut(v) {
+		attributes = append(attributes, "style=filled", "fillcolor=lightblue")
+	}
+	return attributes
+}
+
+// returns the attributes of the edge v-w
+func (h *DOTHighlight) edgeAttributes(v, w int) (attributes []string) {
+	if h == nil {
+		return nil
+	}
+	if h.pairs[[2]int{v, w}] || h.pairs[[2]int{w, v}] {
+		attributes = append(attributes, "color=red", "penwidth=2")
+	} else if h.inCut != nil && h.inCut(v) != h.inCut(w) {
+		attributes = append(attributes, "color=blue", "style=dashed")
+	}
+	return attributes
+}
+
+func dotStatement(s *strings.Builder, statement string, attributes []string) {
+	s.WriteString("\t" + statement)
+	if len(attributes) > 0 {
+		s.WriteString(" [" + strings.Join(attributes, ", ") + "]")
+	}
+	s.WriteString(";\n")
+}
+
+// WriteDOT writes this graph to w in the DOT language with the given highlight, which may be nil.
+// Every vertex and every edge is written, once, so ReadGraphDOT reads back the same graph.
+func (g *Graph) WriteDOT(w io.Writer, h *DOTHighlight) error {
+	var s strings.Builder
+	s.WriteString("graph {\n")
+	for v := 0; v < g.v; v++ {
+		dotStatement(&s, strconv.Itoa(v), h.vertexAttributes(v))
+	}
+	for v := 0; v < g.v; v++ {
+		selfLoops := 0
+		for _, x := range g.adj[v].Values() {
+			u := x.(int)
+			// a self loop is in the list twice
+			if u == v {
+				selfLoops++
+			}
+			if u > v || u == v && selfLoops%2 == 1 {
+				dotStatement(&s, strconv.Itoa(v)+" -- "+strconv.Itoa(u), h.edgeAttributes(v, u))
+			}
+		}
+	}
+	s.WriteString("}\n")
+	_, err := io.WriteString(w, s.String())
+	return err
+}
+
+// ReadGraphDOT reads a graph written by Graph.WriteDOT, ignoring the attributes.
+// Every vertex below the largest one must be named in the input.
+func ReadGraphDOT(r io.Reader) (*Graph, error) {
+	V, edges, err := dot.Read(r, false)
+	if err != nil {
+		return nil, err
+	}
+	G := NewGraph(V)
+	for _, e := range edges {
+		G.AddEdge(e.V, e.W)
+	}
+	return G, nil
+}
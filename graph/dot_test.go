@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_WriteDOT(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 3)
+	G.AddEdge(3, 3)
+
+	var s strings.Builder
+	assert.Nil(G.WriteDOT(&s, NewDOTHighlight().Path(NewDepthFirstPaths(G, 0).PathTo(2))))
+	assert.Equal("graph {\n"+
+		"\t0 [color=red, penwidth=2];\n"+
+		"\t1 [color=red, penwidth=2];\n"+
+		"\t2 [color=red, penwidth=2];\n"+
+		"\t3;\n"+
+		"\t0 -- 1 [color=red, penwidth=2];\n"+
+		"\t1 -- 2 [color=red, penwidth=2];\n"+
+		"\t2 -- 3;\n"+
+		"\t3 -- 3;\n"+
+		"}\n", s.String())
+
+	H, err := ReadGraphDOT(strings.NewReader(s.String()))
+	assert.Nil(err)
+	assert.Equal(G.V(), H.V())
+	assert.Equal(G.E(), H.E())
+	for v := 0; v < G.V(); v++ {
+		assert.ElementsMatch(G.Adj(v), H.Adj(v))
+	}
+
+	// the sides of a bipartite graph form a cut crossed by every edge
+	B := NewGraph(3)
+	B.AddEdge(0, 1)
+	B.AddEdge(1, 2)
+	s.Reset()
+	assert.Nil(B.WriteDOT(&s, NewDOTHighlight().Cut(NewBipartite(B).Color)))
+	assert.Equal(2, strings.Count(s.String(), "color=blue, style=dashed"))
+	assert.Equal(1, strings.Count(s.String(), "fillcolor=lightblue"))
+
+	_, err = ReadGraphDOT(strings.NewReader("digraph {\n}\n"))
+	assert.Error(err)
+	_, err = ReadGraphDOT(strings.NewReader("graph {\n\t0 -- 1 [label=\"1];\n}\n"))
+	assert.Error(err)
+	_, err = ReadGraphDOT(strings.NewReader("graph {\n\t3000000000;\n}\n"))
+	assert.Error(err)
+}
@@ -0,0 +1,443 @@
+package graph
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// The CSV, JSON and GraphML readers and writers exchange graphs and symbol graphs as an edge list: the number of
+// vertices, their names for symbol graphs, and the edges. They share their formats with the edge-weighted graphs
+// of the digraph package, and ignore the weights of the edges when reading them.
+//
+// The CSV format has a header source,target, then a row per edge, preceded by a row with an empty target for
+// every vertex of a symbol graph, to keep their order, and for every isolated vertex of a graph, to keep the
+// number of vertices. Every vertex of a graph must appear in a row, so a file cannot claim more vertices than it has rows.
+//
+// The JSON format is an object {"directed": false, "names": [...], "adjacency": [[{"to": 1}], ...]}
+// with the edges of every vertex in the order of Adj, and both ends of every edge.
+//
+// The GraphML format has a node per vertex, with the name of a symbol graph as id, and an edge per edge.
+
+// edgeList is a graph in the form exchanged by the readers and writers.
+type edgeList struct {
+	V     int
+	names []string // names[v] = name of vertex v, nil for vertices named by their index
+	edges [][2]int
+}
+
+// returns the name of vertex v
+func (l *edgeList) name(v int) string {
+	if l.names == nil {
+		return strconv.Itoa(v)
+	}
+	return l.names[v]
+}
+
+// vertexNames assigns indices to vertex names in the order they are added.
+type vertexNames struct {
+	named bool
+	index map[string]int
+	names []string
+	seen  map[int]bool // vertices named by their index that have been added
+	V     int
+}
+
+func newVertexNames(named bool) *vertexNames {
+	return &vertexNames{named: named, index: make(map[string]int), seen: make(map[int]bool)}
+}
+
+// returns the vertex of the given name, a new one if named, its index otherwise
+func (vn *vertexNames) vertex(name string) (int, error) {
+	if vn.named {
+		v, ok := vn.index[name]
+		if !ok {
+			v = len(vn.names)
+			vn.index[name] = v
+			vn.names = append(vn.names, name)
+			vn.V++
+		}
+		return v, nil
+	}
+	v, err := strconv.Atoi(name)
+	if err != nil || v < 0 {
+		return 0, errors.Errorf("vertex %q is not a non-negative integer", name)
+	}
+	vn.seen[v] = true
+	if v >= vn.V {
+		vn.V = v + 1
+	}
+	return v, nil
+}
+
+// checks that every vertex named by its index below the largest one has been added, so that the number of
+// vertices is bounded by the size of the input rather than by the largest index in it
+func (vn *vertexNames) check() error {
+	if vn.named || len(vn.seen) == vn.V {
+		return nil
+	}
+	// some vertex among the first len(vn.seen) + 1 is missing
+	v := 0
+	for vn.seen[v] {
+		v++
+	}
+	return errors.Errorf("vertex %d is missing, but vertex %d is not", v, vn.V-1)
+}
+
+// CSV
+
+func (l *edgeList) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"source", "target"}); err != nil {
+		return err
+	}
+	degree := make([]int, l.V)
+	for _, e := range l.edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	for v := 0; v < l.V; v++ {
+		if l.names != nil || degree[v] == 0 {
+			if err := cw.Write([]string{l.name(v), ""}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, e := range l.edges {
+		if err := cw.Write([]string{l.name(e[0]), l.name(e[1])}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func readCSV(r io.Reader, named bool) (*edgeList, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 || header[0] != "source" || header[1] != "target" {
+		return nil, errors.New("header must start with source,target")
+	}
+	vn := newVertexNames(named)
+	var edges [][2]int
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		v, err := vn.vertex(record[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		if record[1] == "" {
+			continue
+		}
+		w, err := vn.vertex(record[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		edges = append(edges, [2]int{v, w})
+	}
+	if err := vn.check(); err != nil {
+		return nil, err
+	}
+	l := &edgeList{V: vn.V, edges: edges}
+	if named {
+		l.names = vn.names
+	}
+	return l, nil
+}
+
+// JSON
+
+type jsonGraph struct {
+	Directed  bool         `json:"directed"`
+	Names     []string     `json:"names,omitempty"`
+	Adjacency [][]jsonEdge `json:"adjacency"`
+}
+
+type jsonEdge struct {
+	To int `json:"to"`
+}
+
+func (l *edgeList) writeJSON(w io.Writer) error {
+	g := jsonGraph{Names: l.names, Adjacency: make([][]jsonEdge, l.V)}
+	for v := range g.Adjacency {
+		g.Adjacency[v] = []jsonEdge{}
+	}
+	// adding an edge puts it first in the adjacency lists
+	for i := len(l.edges) - 1; i >= 0; i-- {
+		v, w := l.edges[i][0], l.edges[i][1]
+		g.Adjacency[v] = append(g.Adjacency[v], jsonEdge{To: w})
+		g.Adjacency[w] = append(g.Adjacency[w], jsonEdge{To: v})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+func readJSON(r io.Reader, named bool) (*edgeList, error) {
+	var g jsonGraph
+	if err := json.NewDecoder(r).Decode(&g); err != nil {
+		return nil, err
+	}
+	if g.Directed {
+		return nil, errors.New("expected an undirected graph")
+	}
+	V := len(g.Adjacency)
+	l := &edgeList{V: V}
+	if named {
+		l.names = g.Names
+		if l.names == nil {
+			l.names = make([]string, V)
+			for v := range l.names {
+				l.names[v] = strconv.Itoa(v)
+			}
+		}
+		if len(l.names) != V {
+			return nil, errors.New("expected a name for every vertex")
+		}
+	}
+	// every edge is in both lists, a self loop twice in one
+	count := make(map[[2]int]int)
+	for v, adj := range g.Adjacency {
+		for i := len(adj) - 1; i >= 0; i-- {
+			w := adj[i].To
+			if w < 0 || w >= V {
+				return nil, errors.Errorf("vertex %d is not between 0 and %d", w, V-1)
+			}
+			count[[2]int{v, w}]++
+			if w > v || w == v && count[[2]int{v, v}]%2 == 1 {
+				l.edges = append(l.edges, [2]int{v, w})
+			}
+		}
+	}
+	for pair, n := range count {
+		if pair[0] == pair[1] && n%2 != 0 || count[[2]int{pair[1], pair[0]}] != n {
+			return nil, errors.Errorf("edge %d-%d is not in both adjacency lists", pair[0], pair[1])
+		}
+	}
+	return l, nil
+}
+
+// GraphML
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID string `xml:"id,attr"`
+}
+
+type graphMLEdge struct {
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+func (l *edgeList) writeGraphML(w io.Writer) error {
+	g := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "undirected", Nodes: make([]graphMLNode, l.V)},
+	}
+	for v := range g.Graph.Nodes {
+		g.Graph.Nodes[v].ID = l.name(v)
+	}
+	for _, e := range l.edges {
+		g.Graph.Edges = append(g.Graph.Edges, graphMLEdge{Source: l.name(e[0]), Target: l.name(e[1])})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(g); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readGraphML(r io.Reader, named bool) (*edgeList, error) {
+	var g graphML
+	if err := xml.NewDecoder(r).Decode(&g); err != nil {
+		return nil, err
+	}
+	if g.Graph.EdgeDefault != "undirected" {
+		return nil, errors.New("expected edgedefault undirected")
+	}
+	l := &edgeList{V: len(g.Graph.Nodes)}
+	index := make(map[string]int)
+	for v, node := range g.Graph.Nodes {
+		if _, ok := index[node.ID]; ok {
+			return nil, errors.Errorf("duplicate node %q", node.ID)
+		}
+		index[node.ID] = v
+		if named {
+			l.names = append(l.names, node.ID)
+		}
+	}
+	for _, ge := range g.Graph.Edges {
+		v, ok := index[ge.Source]
+		if !ok {
+			return nil, errors.Errorf("edge from unknown node %q", ge.Source)
+		}
+		w, ok := index[ge.Target]
+		if !ok {
+			return nil, errors.Errorf("edge to unknown node %q", ge.Target)
+		}
+		l.edges = append(l.edges, [2]int{v, w})
+	}
+	return l, nil
+}
+
+// conversions between the graph types and edge lists
+
+func (g *Graph) edgeList() *edgeList {
+	l := &edgeList{V: g.v}
+	for v := 0; v < g.v; v++ {
+		selfLoops := 0
+		for _, x := range g.adj[v].Values() {
+			w := x.(int)
+			// a self loop is in the list twice
+			if w == v {
+				selfLoops++
+			}
+			if w > v || w == v && selfLoops%2 == 1 {
+				l.edges = append(l.edges, [2]int{v, w})
+			}
+		}
+	}
+	return l
+}
+
+func (l *edgeList) graph() *Graph {
+	G := NewGraph(l.V)
+	for _, e := range l.edges {
+		G.AddEdge(e[0], e[1])
+	}
+	return G
+}
+
+func (sg *SymbolGraph) edgeList() *edgeList {
+	l := sg.graph.edgeList()
+	l.names = make([]string, len(sg.keys))
+	for v, name := range sg.keys {
+		l.names[v] = string(name)
+	}
+	return l
+}
+
+func (l *edgeList) symbolGraph() (*SymbolGraph, error) {
+	return newSymbolGraphNames(l.names, l.graph())
+}
+
+// WriteCSV writes this graph to w as an edge-list CSV file.
+func (g *Graph) WriteCSV(w io.Writer) error {
+	return g.edgeList().writeCSV(w)
+}
+
+// WriteJSON writes this graph to w as JSON adjacency lists.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	return g.edgeList().writeJSON(w)
+}
+
+// WriteGraphML writes this graph to w as a GraphML document.
+func (g *Graph) WriteGraphML(w io.Writer) error {
+	return g.edgeList().writeGraphML(w)
+}
+
+// ReadGraphCSV reads a graph from an edge-list CSV file written by Graph.WriteCSV.
+func ReadGraphCSV(r io.Reader) (*Graph, error) {
+	l, err := readCSV(r, false)
+	if err != nil {
+		return nil, err
+	}
+	return l.graph(), nil
+}
+
+// ReadGraphJSON reads a graph from JSON adjacency lists written by Graph.WriteJSON.
+func ReadGraphJSON(r io.Reader) (*Graph, error) {
+	l, err := readJSON(r, false)
+	if err != nil {
+		return nil, err
+	}
+	return l.graph(), nil
+}
+
+// ReadGraphGraphML reads a graph from a GraphML document written by Graph.WriteGraphML,
+// numbering the nodes in their order.
+func ReadGraphGraphML(r io.Reader) (*Graph, error) {
+	l, err := readGraphML(r, false)
+	if err != nil {
+		return nil, err
+	}
+	return l.graph(), nil
+}
+
+// WriteCSV writes this symbol graph to w as an edge-list CSV file of vertex names.
+func (sg *SymbolGraph) WriteCSV(w io.Writer) error {
+	return sg.edgeList().writeCSV(w)
+}
+
+// WriteJSON writes this symbol graph to w as JSON adjacency lists with the vertex names.
+func (sg *SymbolGraph) WriteJSON(w io.Writer) error {
+	return sg.edgeList().writeJSON(w)
+}
+
+// WriteGraphML writes this symbol graph to w as a GraphML document with the vertex names as node ids.
+func (sg *SymbolGraph) WriteGraphML(w io.Writer) error {
+	return sg.edgeList().writeGraphML(w)
+}
+
+// ReadSymbolGraphCSV reads a symbol graph from an edge-list CSV file written by SymbolGraph.WriteCSV,
+// numbering the vertices in the order their names first appear.
+func ReadSymbolGraphCSV(r io.Reader) (*SymbolGraph, error) {
+	l, err := readCSV(r, true)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolGraph()
+}
+
+// ReadSymbolGraphJSON reads a symbol graph from JSON adjacency lists written by SymbolGraph.WriteJSON,
+// naming the vertices by their index if the names are missing.
+func ReadSymbolGraphJSON(r io.Reader) (*SymbolGraph, error) {
+	l, err := readJSON(r, true)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolGraph()
+}
+
+// ReadSymbolGraphGraphML reads a symbol graph from a GraphML document written by SymbolGraph.WriteGraphML,
+// naming the vertices by their node ids.
+func ReadSymbolGraphGraphML(r io.Reader) (*SymbolGraph, error) {
+	l, err := readGraphML(r, true)
+	if err != nil {
+		return nil, err
+	}
+	return l.symbolGraph()
+}
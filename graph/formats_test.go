@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_WriteCSV(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(5)
+	G.AddEdge(0, 1)
+	G.AddEdge(2, 0)
+	G.AddEdge(3, 3)
+	G.AddEdge(0, 1)
+
+	var b bytes.Buffer
+	assert.Nil(G.WriteCSV(&b))
+	assert.Equal("source,target\n4,\n0,1\n0,2\n0,1\n3,3\n", b.String())
+
+	write := []func(io.Writer) error{G.WriteCSV, G.WriteJSON, G.WriteGraphML}
+	read := []func(io.Reader) (*Graph, error){ReadGraphCSV, ReadGraphJSON, ReadGraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.V(), H.V())
+		assert.Equal(G.E(), H.E())
+		for v := 0; v < G.V(); v++ {
+			assert.ElementsMatch(G.Adj(v), H.Adj(v))
+		}
+	}
+
+	// weights are ignored
+	H, err := ReadGraphCSV(strings.NewReader("source,target,weight\n0,1,0.5\n"))
+	assert.Nil(err)
+	assert.Equal([]int{1}, H.Adj(0))
+	// every vertex appears in a row, so a large index does not allocate its vertices
+	_, err = ReadGraphCSV(strings.NewReader("source,target\n1000000000000,\n"))
+	assert.EqualError(err, "vertex 0 is missing, but vertex 1000000000000 is not")
+	_, err = ReadGraphCSV(strings.NewReader("source,target\n0,1\n3,\n"))
+	assert.EqualError(err, "vertex 2 is missing, but vertex 3 is not")
+	_, err = ReadGraphJSON(strings.NewReader(`{"directed": true, "adjacency": []}`))
+	assert.Error(err)
+	_, err = ReadGraphJSON(strings.NewReader(`{"directed": false, "adjacency": [[{"to": 0}]]}`))
+	assert.Error(err)
+	_, err = ReadGraphGraphML(strings.NewReader(`<graphml><graph edgedefault="directed"></graph></graphml>`))
+	assert.Error(err)
+}
+
+func TestSymbolGraph_WriteJSON(t *testing.T) {
+	assert := assert.New(t)
+	names := []string{"JFK", "ORD", "0", "\"LAX\""}
+	G := NewGraph(4)
+	G.AddEdge(1, 3)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 3)
+	sg, err := newSymbolGraphNames(names, G)
+	assert.Nil(err)
+
+	var b bytes.Buffer
+	write := []func(io.Writer) error{sg.WriteCSV, sg.WriteJSON, sg.WriteGraphML}
+	read := []func(io.Reader) (*SymbolGraph, error){ReadSymbolGraphCSV, ReadSymbolGraphJSON, ReadSymbolGraphGraphML}
+	for i := range write {
+		b.Reset()
+		assert.Nil(write[i](&b))
+		H, err := read[i](&b)
+		assert.Nil(err)
+		assert.Equal(G.E(), H.Graph().E())
+		for v, name := range names {
+			assert.Equal(name, H.NameOf(v))
+			assert.Equal(v, H.IndexOf(name))
+			assert.ElementsMatch(G.Adj(v), H.Graph().Adj(v))
+		}
+	}
+
+	_, err = ReadSymbolGraphGraphML(strings.NewReader(
+		`<graphml><graph edgedefault="undirected"><node id="a"/><node id="a"/></graph></graphml>`))
+	assert.Error(err)
+}
@@ -33,12 +33,16 @@ import (
 )
 
 // Graph struct represents an undirected graph of vertices named 0 through V – 1.
+// Besides adding edges, it supports removing edges and vertices, contracting vertices,
+// and extracting induced subgraphs.
 // This implementation uses an adjacency-lists representation,
 // which is a vertex-indexed array of Bag objects. It uses O(E + V) space,
 // where E is the number of edges and V is the number of vertices.
 // All instance methods take O(1) time.
 // (Though, iterating over the vertices returned by adj(int)
-// takes time proportional to the degree of the vertex.)
+// takes time proportional to the degree of the vertex, removing an edge v-w
+// takes time proportional to the degrees of v and w, and removing or contracting
+// vertices and extracting subgraphs take O(E + V) time, however many vertices are removed at once.)
 // Constructing an empty graph with V vertices takes O(V) time;
 // constructing a graph with E edges and V vertices takes O(E + V) time.
 type Graph struct {
@@ -112,6 +116,122 @@ func (g *Graph) AddEdge(v, w int) {
 	g.adj[w].Add(v)
 }
 
+// RemoveEdge removes one undirected edge v-w from this graph, and returns true if there was one.
+func (g *Graph) RemoveEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.adj[v].Remove(w) {
+		return false
+	}
+	g.adj[w].Remove(v) // the second occurrence of a self loop
+	g.e--
+	return true
+}
+
+// RemoveVertex removes vertex v and the edges incident on it from this graph, renumbering every vertex
+// after v down by one so the vertices remain 0 through V - 1. It takes O(E + V) time; to remove several
+// vertices, use RemoveVertices.
+func (g *Graph) RemoveVertex(v int) {
+	g.RemoveVertices([]int{v})
+}
+
+// RemoveVertices removes the given vertices and the edges incident on them from this graph in one pass,
+// renumbering the remaining vertices in order so they remain 0 through V - 1, and returns the new number
+// of every vertex, -1 for the removed ones. It takes O(E + V) time in all, so pruning leaves or peeling
+// a k-core removes each round of vertices with one call rather than one call per vertex.
+func (g *Graph) RemoveVertices(vertices []int) []int {
+	index := removal(g.v, vertices)
+	V := g.v - len(vertices)
+	g.adj, g.e = g.relabel(index, V)
+	g.v = V
+	return index
+}
+
+// Contract contracts vertices v and w of this graph into one: it removes the edges between them, makes the other
+// edges incident on w incident on v, and removes w as RemoveVertex does. It returns the new number of v,
+// and takes O(E + V) time.
+func (g *Graph) Contract(v, w int) int {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if v == w {
+		panic("cannot contract a vertex with itself")
+	}
+	for g.RemoveEdge(v, w) {
+	}
+	index := removal(g.v, []int{w})
+	index[w] = index[v]
+	g.adj, g.e = g.relabel(index, g.v-1)
+	g.v--
+	return index[v]
+}
+
+// InducedSubgraph returns the subgraph induced by the given vertices, with vertex i for vertices[i] and every
+// edge between them, and the index of every vertex of this graph in the subgraph, -1 if it is not in it.
+// It takes O(E + V) time.
+func (g *Graph) InducedSubgraph(vertices []int) (*Graph, []int) {
+	index := subgraphIndex(g.v, vertices)
+	adj, e := g.relabel(index, len(vertices))
+	return &Graph{v: len(vertices), e: e, adj: adj}, index
+}
+
+// returns the index of every vertex of V vertices after removing the given vertices, -1 for them
+func removal(V int, vertices []int) []int {
+	index := subgraphIndex(V, vertices)
+	next := 0
+	for u := range index {
+		if index[u] >= 0 {
+			index[u] = -1
+		} else {
+			index[u] = next
+			next++
+		}
+	}
+	return index
+}
+
+// returns the index of every vertex of V vertices in the subgraph of the given vertices, -1 for the others
+func subgraphIndex(V int, vertices []int) []int {
+	index := make([]int, V)
+	for v := range index {
+		index[v] = -1
+	}
+	for i, v := range vertices {
+		if v < 0 || v >= V {
+			panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+		}
+		if index[v] >= 0 {
+			panic(fmt.Sprintln("vertex ", v, " is repeated"))
+		}
+		index[v] = i
+	}
+	return index
+}
+
+// returns the adjacency lists of the graph on V vertices with vertex index[u] for every vertex u of this graph
+// with index[u] >= 0, and the edges between them in the same order, and its number of edges
+func (g *Graph) relabel(index []int, V int) ([]*bag.Bag, int) {
+	adj := make([]*bag.Bag, V)
+	for v := range adj {
+		adj[v] = bag.New()
+	}
+	entries := 0
+	for u := 0; u < g.v; u++ {
+		if index[u] < 0 {
+			continue
+		}
+		// in reverse, as adding reverses the lists
+		values := g.adj[u].Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			if w := index[values[i].(int)]; w >= 0 {
+				adj[index[u]].Add(w)
+				entries++
+			}
+		}
+	}
+	// every edge is in two lists, a self loop twice in one
+	return adj, entries / 2
+}
+
 // Degree returns the degree of vertex v.
 func (g *Graph) Degree(v int) int {
 	g.validateVertex(v)
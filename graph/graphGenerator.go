@@ -8,17 +8,47 @@ import (
 
 	"github.com/emirpasic/gods/sets/treeset"
 	"github.com/handane123/algorithms/dataStructure/priorityqueue"
+	"github.com/handane123/algorithms/internal/graphgen"
 )
 
 // GraphGenerator struct provides static methods for creating various graphs,
 // including Erdos-Renyi random graphs, random bipartite graphs, random k-regular graphs,
-// and random rooted trees.
+// random rooted trees, Barabasi-Albert, Watts-Strogatz, R-MAT and Kronecker graphs,
+// configuration-model graphs with given degrees, and grids, tori, hypercubes and random geometric graphs
+// with the coordinates of their vertices.
+// It has no edge-weighted variants of the geometric graphs: the edge-weighted graph types live in the digraph
+// package, which imports this one, so DigraphGenerator provides them as WeightedGrid, WeightedTorus,
+// WeightedHypercube and WeightedRandomGeometric.
+// Generators constructed with equal seeds produce identical graphs when their methods are called in the same order.
+// The zero value is ready to use and, like NewGraphGenerator, seeds itself with the current time on first use.
+// A generator is not safe for concurrent use.
 type GraphGenerator struct {
+	random *rand.Rand // source of randomness of this generator
 }
 
-// NewGraphGenerator constructs the GraphGenerator struct
+// NewGraphGenerator constructs the GraphGenerator struct, seeded with the current time.
 func NewGraphGenerator() *GraphGenerator {
-	return &GraphGenerator{}
+	return NewGraphGeneratorSeed(time.Now().UnixNano())
+}
+
+// NewGraphGeneratorSeed constructs the GraphGenerator struct with the given seed; generators with equal seeds
+// produce identical graphs when called in the same order.
+func NewGraphGeneratorSeed(seed int64) *GraphGenerator {
+	return NewGraphGeneratorRand(rand.New(rand.NewSource(seed)))
+}
+
+// NewGraphGeneratorRand constructs the GraphGenerator struct that draws its random numbers from r,
+// leaving the global source of math/rand untouched.
+func NewGraphGeneratorRand(r *rand.Rand) *GraphGenerator {
+	return &GraphGenerator{random: r}
+}
+
+// returns the source of randomness of this generator, seeding it with the current time if there is none yet
+func (generator *GraphGenerator) rng() *rand.Rand {
+	if generator.random == nil {
+		generator.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return generator.random
 }
 
 type edge struct {
@@ -61,12 +91,11 @@ func (generator *GraphGenerator) Simple(V, E int) (*Graph, error) {
 	if E < 0 {
 		return nil, errors.New("too few edges")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V)
 	set := treeset.NewWith(comparator)
 	for G.E() < E {
-		v := rand.Intn(V)
-		w := rand.Intn(V)
+		v := generator.rng().Intn(V)
+		w := generator.rng().Intn(V)
 		e := newEdge(v, w)
 		if v != w && !set.Contains(e) {
 			set.Add(e)
@@ -81,11 +110,10 @@ func (generator *GraphGenerator) SimpleP(V int, p float64) (*Graph, error) {
 	if p < 0.0 || p > 1.0 {
 		return nil, errors.New("probability must be between 0 and 1")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V)
 	for v := 0; v < V; v++ {
 		for w := v + 1; w < V; w++ {
-			if rand.Float64() < p {
+			if generator.rng().Float64() < p {
 				G.AddEdge(v, w)
 			}
 		}
@@ -114,13 +142,12 @@ func (generator *GraphGenerator) BipartiteGraph(V1, V2, E int) (*Graph, error) {
 	if E < 0 {
 		return nil, errors.New("too few edges")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V1 + V2)
-	vertices := createVertices(V1 + V2)
+	vertices := generator.createVertices(V1 + V2)
 	set := treeset.NewWith(comparator)
 	for G.E() < E {
-		i := rand.Intn(V1)
-		j := V1 + rand.Intn(V2)
+		i := generator.rng().Intn(V1)
+		j := V1 + generator.rng().Intn(V2)
 		e := newEdge(vertices[i], vertices[j])
 		if !set.Contains(e) {
 			set.Add(e)
@@ -135,12 +162,11 @@ func (generator *GraphGenerator) BipartiteP(V1, V2 int, p float64) (*Graph, erro
 	if p < 0.0 || p > 1.0 {
 		return nil, errors.New("probability must be between 0 and 1")
 	}
-	rand.Seed(time.Now().Unix())
-	vertices := createVertices(V1 + V2)
+	vertices := generator.createVertices(V1 + V2)
 	G := NewGraph(V1 + V2)
 	for i := 0; i < V1; i++ {
 		for j := 0; j < V2; j++ {
-			if rand.Float64() < p {
+			if generator.rng().Float64() < p {
 				G.AddEdge(vertices[i], vertices[V1+j])
 			}
 		}
@@ -151,7 +177,7 @@ func (generator *GraphGenerator) BipartiteP(V1, V2 int, p float64) (*Graph, erro
 // PathGraph returns a path graph on V vertices.
 func (generator *GraphGenerator) PathGraph(V int) *Graph {
 	G := NewGraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
 	}
@@ -161,7 +187,7 @@ func (generator *GraphGenerator) PathGraph(V int) *Graph {
 // BinaryTree returns a complete binary tree graph on V vertices.
 func (generator *GraphGenerator) BinaryTree(V int) *Graph {
 	G := NewGraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 1; i < V; i++ {
 		G.AddEdge(vertices[i], vertices[(i-1)/2])
 	}
@@ -171,7 +197,7 @@ func (generator *GraphGenerator) BinaryTree(V int) *Graph {
 // CycleGraph returns a cycle graph on V vertices.
 func (generator *GraphGenerator) CycleGraph(V int) *Graph {
 	G := NewGraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 	for i := 0; i < V-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
 	}
@@ -187,11 +213,10 @@ func (generator *GraphGenerator) EulerianCycleGraph(V, E int) (*Graph, error) {
 	if V <= 0 {
 		return nil, errors.New("an Eulerian cycle must have at least one vertex")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V)
 	vertices := make([]int, E)
 	for i := 0; i < E; i++ {
-		vertices[i] = rand.Intn(V)
+		vertices[i] = generator.rng().Intn(V)
 	}
 	for i := 0; i < E-1; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
@@ -208,11 +233,10 @@ func (generator *GraphGenerator) EulerianPathGraph(V, E int) (*Graph, error) {
 	if V <= 0 {
 		return nil, errors.New("an Eulerian path must have at least one vertex")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V)
 	vertices := make([]int, E+1)
 	for i := 0; i < E+1; i++ {
-		vertices[i] = rand.Intn(V)
+		vertices[i] = generator.rng().Intn(V)
 	}
 	for i := 0; i < E; i++ {
 		G.AddEdge(vertices[i], vertices[i+1])
@@ -226,7 +250,7 @@ func (generator *GraphGenerator) Wheel(V int) (*Graph, error) {
 		return nil, errors.New("number of vertices must be at least 2")
 	}
 	G := NewGraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 
 	// simple cycle on V-1 vertices
 	for i := 1; i < V-1; i++ {
@@ -247,7 +271,7 @@ func (generator *GraphGenerator) Star(V int) (*Graph, error) {
 		return nil, errors.New("number of vertices must be at least 1")
 	}
 	G := NewGraph(V)
-	vertices := createVertices(V)
+	vertices := generator.createVertices(V)
 
 	// connect vertices[0] to every other vertex
 	for i := 1; i < V; i++ {
@@ -261,7 +285,6 @@ func (generator *GraphGenerator) Regular(V, k int) (*Graph, error) {
 	if V*k%2 != 0 {
 		return nil, errors.New("number of vertices * k must be even")
 	}
-	rand.Seed(time.Now().Unix())
 	G := NewGraph(V)
 	vertices := make([]int, V*k)
 	for v := 0; v < V; v++ {
@@ -269,7 +292,7 @@ func (generator *GraphGenerator) Regular(V, k int) (*Graph, error) {
 			vertices[v+V*j] = v
 		}
 	}
-	rand.Shuffle(V*k, func(i, j int) {
+	generator.rng().Shuffle(V*k, func(i, j int) {
 		vertices[i], vertices[j] = vertices[j], vertices[i]
 	})
 	for i := 0; i < V*k/2; i++ {
@@ -302,10 +325,9 @@ func (generator *GraphGenerator) Tree(V int) *Graph {
 	// Prufer sequence: sequence of V-2 values between 0 and V-1
 	// Prufer's proof of Cayley's theorem: Prufer sequences are in 1-1
 	// with labeled trees on V vertices
-	rand.Seed(time.Now().Unix())
 	prufer := make([]int, V-2)
 	for i := 0; i < V-2; i++ {
-		prufer[i] = rand.Intn(V)
+		prufer[i] = generator.rng().Intn(V)
 	}
 	// degree of vertex v = 1 + number of times it appers in Prufer sequence
 	degree := make([]int, V)
@@ -338,13 +360,179 @@ func (generator *GraphGenerator) Tree(V int) *Graph {
 	return G
 }
 
-func createVertices(capacity int) []int {
-	rand.Seed(time.Now().Unix())
+// BarabasiAlbert returns a random scale-free graph on V vertices grown by preferential attachment: starting from
+// m isolated vertices, every new vertex is joined to m distinct earlier vertices, each chosen with probability
+// proportional to its degree (the first new vertex is joined to all m).
+func (generator *GraphGenerator) BarabasiAlbert(V, m int) (*Graph, error) {
+	if m < 1 || m >= V {
+		return nil, errors.New("m must be between 1 and V-1")
+	}
+	G := NewGraph(V)
+	// every vertex appears once for each incident edge, so a uniform choice is proportional to degree
+	var ends []int
+	for v := m; v < V; v++ {
+		targets := make([]int, 0, m)
+		chosen := make(map[int]bool)
+		for len(targets) < m {
+			w := len(targets)
+			if v > m {
+				w = ends[generator.rng().Intn(len(ends))]
+			}
+			if !chosen[w] {
+				chosen[w] = true
+				targets = append(targets, w)
+			}
+		}
+		for _, w := range targets {
+			G.AddEdge(v, w)
+			ends = append(ends, v, w)
+		}
+	}
+	return G, nil
+}
+
+// WattsStrogatz returns a random small-world graph on V vertices: a ring in which every vertex is joined to its
+// k nearest neighbors, k/2 on each side, whose edges are rewired with probability p by replacing one endpoint with
+// a uniformly random vertex, avoiding self-loops and parallel edges.
+func (generator *GraphGenerator) WattsStrogatz(V, k int, p float64) (*Graph, error) {
+	if k < 0 || k%2 != 0 {
+		return nil, errors.New("k must be even and non negative")
+	}
+	if k >= V {
+		return nil, errors.New("k must be less than the number of vertices")
+	}
+	if p < 0.0 || p > 1.0 {
+		return nil, errors.New("probability must be between 0 and 1")
+	}
+	var edges []*edge
+	set := treeset.NewWith(comparator)
+	degree := make([]int, V)
+	for j := 1; j <= k/2; j++ {
+		for v := 0; v < V; v++ {
+			e := newEdge(v, (v+j)%V)
+			edges = append(edges, e)
+			set.Add(e)
+			degree[v]++
+			degree[(v+j)%V]++
+		}
+	}
+	// rewire the edge from v to its j-th neighbor clockwise, keeping v
+	for i, e := range edges {
+		v := i % V
+		u := e.v + e.w - v
+		if degree[v] == V-1 || generator.rng().Float64() >= p {
+			continue
+		}
+		w := generator.rng().Intn(V)
+		for w == v || set.Contains(newEdge(v, w)) {
+			w = generator.rng().Intn(V)
+		}
+		set.Remove(e)
+		degree[u]--
+		edges[i] = newEdge(v, w)
+		set.Add(edges[i])
+		degree[w]++
+	}
+	G := NewGraph(V)
+	for _, e := range edges {
+		G.AddEdge(e.v, e.w)
+	}
+	return G, nil
+}
+
+// RMAT returns a random simple graph on 2^scale vertices and E edges generated by the R-MAT model: every edge is
+// placed by descending scale levels of the adjacency matrix, choosing the top-left, top-right, bottom-left or
+// bottom-right quadrant with probabilities a, b, c and 1-a-b-c; self-loops and repeated edges are discarded.
+func (generator *GraphGenerator) RMAT(scale, E int, a, b, c float64) (*Graph, error) {
+	if err := graphgen.ValidateRMAT(scale, a, b, c); err != nil {
+		return nil, err
+	}
+	V := 1 << scale
+	if E > V*(V-1)/2 {
+		return nil, errors.New("too many edges")
+	}
+	if E < 0 {
+		return nil, errors.New("too few edges")
+	}
+	// the probabilities may confine the edges to too few entries to ever draw E of them
+	if E > graphgen.RMATEdges(scale, a, b, c, false) {
+		return nil, errors.New("too many edges for the probabilities")
+	}
+	G := NewGraph(V)
+	set := treeset.NewWith(comparator)
+	for G.E() < E {
+		v, w := graphgen.RMATEdge(generator.rng(), scale, a, b, c)
+		e := newEdge(v, w)
+		if v != w && !set.Contains(e) {
+			set.Add(e)
+			G.AddEdge(v, w)
+		}
+	}
+	return G, nil
+}
+
+// Kronecker returns a random graph drawn from the stochastic Kronecker graph given by the k-th Kronecker power of
+// a symmetric n-by-n initiator matrix of probabilities: it has n^k vertices, and two distinct vertices, written
+// as k digits in base n, are joined with probability equal to the product of the initiator entries indexed by
+// their digits. It takes time proportional to n^2k.
+func (generator *GraphGenerator) Kronecker(initiator [][]float64, k int) (*Graph, error) {
+	if err := graphgen.ValidateInitiator(initiator, k); err != nil {
+		return nil, err
+	}
+	n := len(initiator)
+	for i := range initiator {
+		for j := range initiator[i] {
+			if initiator[i][j] != initiator[j][i] {
+				return nil, errors.New("initiator must be symmetric")
+			}
+		}
+	}
+	V := 1
+	for i := 0; i < k; i++ {
+		V *= n
+	}
+	G := NewGraph(V)
+	for v := 0; v < V; v++ {
+		for w := v + 1; w < V; w++ {
+			if generator.rng().Float64() < graphgen.KroneckerProbability(initiator, k, v, w) {
+				G.AddEdge(v, w)
+			}
+		}
+	}
+	return G, nil
+}
+
+// ConfigurationModel returns a random graph (not necessarily simple) in which vertex v has degree degrees[v],
+// built by pairing the endpoints of the edges uniformly at random; a self-loop adds 2 to the degree of its vertex.
+func (generator *GraphGenerator) ConfigurationModel(degrees []int) (*Graph, error) {
+	var stubs []int
+	for v, d := range degrees {
+		if d < 0 {
+			return nil, errors.New("degrees must be non negative")
+		}
+		for i := 0; i < d; i++ {
+			stubs = append(stubs, v)
+		}
+	}
+	if len(stubs)%2 != 0 {
+		return nil, errors.New("sum of degrees must be even")
+	}
+	generator.rng().Shuffle(len(stubs), func(i, j int) {
+		stubs[i], stubs[j] = stubs[j], stubs[i]
+	})
+	G := NewGraph(len(degrees))
+	for i := 0; i < len(stubs); i += 2 {
+		G.AddEdge(stubs[i], stubs[i+1])
+	}
+	return G, nil
+}
+
+func (generator *GraphGenerator) createVertices(capacity int) []int {
 	vertices := make([]int, capacity)
 	for i := 0; i < capacity; i++ {
 		vertices[i] = i
 	}
-	rand.Shuffle(capacity, func(i, j int) {
+	generator.rng().Shuffle(capacity, func(i, j int) {
 		vertices[i], vertices[j] = vertices[j], vertices[i]
 	})
 	return vertices
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"github.com/handane123/algorithms/internal/graphgen"
+)
+
+// returns the graph with the edges of the lattice
+func latticeGraph(l *graphgen.Lattice) *Graph {
+	G := NewGraph(len(l.Coords))
+	for _, e := range l.Edges {
+		G.AddEdge(e[0], e[1])
+	}
+	return G
+}
+
+// Grid returns the grid graph with sizes[i] vertices along dimension i (two dimensions for a 2D grid, three
+// for a 3D grid) and the coordinates of every vertex. Vertex v has coordinates given by the digits of v in the
+// mixed radix of the sizes, the first fastest, so in a 2D grid vertex x + sizes[0] y is at (x, y).
+// DigraphGenerator offers edge-weighted variants of this and the other geometric graphs.
+func (generator *GraphGenerator) Grid(sizes []int) (*Graph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeGraph(l), l.Coords, nil
+}
+
+// Torus returns the grid graph with sizes[i] vertices along dimension i, at least 3, wrapped around along
+// every dimension, and the coordinates of every vertex as for Grid.
+func (generator *GraphGenerator) Torus(sizes []int) (*Graph, [][]float64, error) {
+	l, err := graphgen.NewLattice(sizes, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeGraph(l), l.Coords, nil
+}
+
+// Hypercube returns the hypercube graph of the given dimension, on the 2^dimension vertices whose binary digits
+// are their coordinates, with an edge between vertices that differ in one digit.
+func (generator *GraphGenerator) Hypercube(dimension int) (*Graph, [][]float64, error) {
+	l, err := graphgen.NewHypercube(dimension)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeGraph(l), l.Coords, nil
+}
+
+// RandomGeometric returns a random geometric graph on V uniformly random points of the unit cube of the given
+// dimension, with an edge between points at distance at most radius, and the coordinates of every vertex.
+func (generator *GraphGenerator) RandomGeometric(V, dimension int, radius float64) (*Graph, [][]float64, error) {
+	l, err := graphgen.NewGeometricLattice(generator.rng(), V, dimension, radius)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latticeGraph(l), l.Coords, nil
+}
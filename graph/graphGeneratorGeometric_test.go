@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphGenerator_Grid(t *testing.T) {
+	generator := NewGraphGeneratorSeed(43)
+	assert := assert.New(t)
+
+	G, coords, err := generator.Grid([]int{4, 3})
+	assert.Nil(err)
+	assert.Equal(12, G.V())
+	assert.Equal(3*3+4*2, G.E())
+	assert.Equal([]float64{1, 2}, coords[9])
+	assert.ElementsMatch([]int{8, 10, 5}, G.Adj(9))
+
+	G, coords, err = generator.Grid([]int{3, 3, 3})
+	assert.Nil(err)
+	assert.Equal(54, G.E())
+	assert.Equal([]float64{1, 1, 1}, coords[13])
+	assert.Equal(6, G.Degree(13))
+
+	G, _, err = generator.Torus([]int{5, 4})
+	assert.Nil(err)
+	assert.Equal(40, G.E())
+	for v := 0; v < G.V(); v++ {
+		assert.Equal(4, G.Degree(v))
+	}
+	finder := NewCycle(G)
+	assert.False(finder.hasParallelEdges(G))
+
+	_, _, err = generator.Torus([]int{5, 2})
+	assert.EqualError(err, "torus sides must be at least 3")
+	_, _, err = generator.Grid([]int{5, 0})
+	assert.EqualError(err, "grid sides must be positive")
+}
+
+func TestGraphGenerator_Hypercube(t *testing.T) {
+	generator := NewGraphGeneratorSeed(43)
+	assert := assert.New(t)
+	G, coords, err := generator.Hypercube(4)
+	assert.Nil(err)
+	assert.Equal(16, G.V())
+	assert.Equal(32, G.E())
+	assert.Equal([]float64{1, 0, 1, 1}, coords[13])
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			x := v ^ w
+			assert.True(x > 0 && x&(x-1) == 0)
+		}
+	}
+	G, _, err = generator.Hypercube(0)
+	assert.Nil(err)
+	assert.Equal(1, G.V())
+	_, _, err = generator.Hypercube(-1)
+	assert.Error(err)
+}
+
+func TestGraphGenerator_RandomGeometric(t *testing.T) {
+	generator := NewGraphGeneratorSeed(43)
+	assert := assert.New(t)
+	radius := 0.15
+	G, coords, err := generator.RandomGeometric(300, 2, radius)
+	assert.Nil(err)
+	adjacent := make(map[[2]int]bool)
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			adjacent[[2]int{v, w}] = true
+		}
+	}
+	for v := 0; v < G.V(); v++ {
+		for w := 0; w < G.V(); w++ {
+			if v != w {
+				d := math.Hypot(coords[v][0]-coords[w][0], coords[v][1]-coords[w][1])
+				assert.Equal(d <= radius, adjacent[[2]int{v, w}])
+			}
+		}
+	}
+	_, _, err = generator.RandomGeometric(10, 0, 0.1)
+	assert.Error(err)
+	_, _, err = generator.RandomGeometric(10, 2, -0.1)
+	assert.Error(err)
+}
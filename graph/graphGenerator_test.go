@@ -1,6 +1,9 @@
 package graph
 
 import (
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +31,17 @@ func TestGraphGenerator_Simple(t *testing.T) {
 	assert.False(finder.hasSelfLoop(g2))
 }
 
+func TestGraphGenerator_ZeroValue(t *testing.T) {
+	assert := assert.New(t)
+	var generator GraphGenerator
+	g, err := generator.Simple(20, 30)
+	assert.Nil(err)
+	assert.Equal(30, g.E())
+	assert.NotNil(generator.random)
+	tree := (&GraphGenerator{}).Tree(10)
+	assert.Equal(9, tree.E())
+}
+
 func TestGraphGenerator_SimpleP(t *testing.T) {
 	generator := NewGraphGenerator()
 	assert := assert.New(t)
@@ -266,3 +280,180 @@ func TestVkey(t *testing.T) {
 	var b vkey = 1
 	assert.Equal(0, a.CompareTo(b))
 }
+
+// returns the edges of G as a string, each one listed once from its smaller endpoint
+func edgeString(G *Graph) string {
+	var s strings.Builder
+	for v := 0; v < G.V(); v++ {
+		for _, w := range G.Adj(v) {
+			if v <= w {
+				fmt.Fprintf(&s, " %d-%d", v, w)
+			}
+		}
+	}
+	return s.String()
+}
+
+func TestGraphGenerator_Seed(t *testing.T) {
+	assert := assert.New(t)
+
+	// golden graphs: a change in these means that seeded graphs are no longer reproducible
+	generator := NewGraphGeneratorSeed(2021)
+	simple, _ := generator.Simple(6, 7)
+	assert.Equal(" 0-4 0-3 1-3 2-3 3-5 3-4 4-5", edgeString(simple))
+	bipartite, _ := generator.BipartiteGraph(3, 3, 4)
+	assert.Equal(" 0-1 0-2 1-5 4-5", edgeString(bipartite))
+	assert.Equal(" 0-6 1-5 2-4 2-5 3-5 4-6", edgeString(generator.Tree(7)))
+	regular, _ := generator.Regular(6, 3)
+	assert.Equal(" 0-3 0-5 0-1 1-3 1-4 2-4 2-5 2-3 4-5", edgeString(regular))
+
+	// equal seeds produce identical graphs, whether given as a seed or as a source
+	generate := func(generator *GraphGenerator) (graphs []string) {
+		simpleP, _ := generator.SimpleP(10, 0.3)
+		bipartiteP, _ := generator.BipartiteP(4, 5, 0.5)
+		cycle, _ := generator.EulerianCycleGraph(6, 9)
+		path, _ := generator.EulerianPathGraph(6, 9)
+		wheel, _ := generator.Wheel(7)
+		star, _ := generator.Star(7)
+		for _, G := range []*Graph{simpleP, bipartiteP, cycle, path, wheel, star,
+			generator.PathGraph(8), generator.BinaryTree(8), generator.CycleGraph(8), generator.Tree(20)} {
+			graphs = append(graphs, edgeString(G))
+		}
+		return graphs
+	}
+	assert.Equal(generate(NewGraphGeneratorSeed(7)), generate(NewGraphGeneratorRand(rand.New(rand.NewSource(7)))))
+	assert.NotEqual(generate(NewGraphGeneratorSeed(7)), generate(NewGraphGeneratorSeed(8)))
+}
+
+func TestGraphGenerator_BarabasiAlbert(t *testing.T) {
+	generator := NewGraphGeneratorSeed(42)
+	assert := assert.New(t)
+	G, err := generator.BarabasiAlbert(500, 3)
+	assert.Nil(err)
+	assert.Equal(3*(500-3), G.E())
+	finder := NewCycle(G)
+	assert.False(finder.hasParallelEdges(G))
+	assert.False(finder.hasSelfLoop(G))
+	assert.Equal(1, NewCC(G).Count())
+	// preferential attachment favors the oldest vertices
+	maxDegree := 0
+	for v := 0; v < G.V(); v++ {
+		if G.Degree(v) > maxDegree {
+			maxDegree = G.Degree(v)
+		}
+	}
+	assert.True(maxDegree > 30)
+
+	_, err = generator.BarabasiAlbert(5, 5)
+	assert.Error(err)
+	_, err = generator.BarabasiAlbert(5, 0)
+	assert.Error(err)
+}
+
+func TestGraphGenerator_WattsStrogatz(t *testing.T) {
+	generator := NewGraphGeneratorSeed(42)
+	assert := assert.New(t)
+
+	// no rewiring leaves the ring lattice
+	G, err := generator.WattsStrogatz(10, 4, 0)
+	assert.Nil(err)
+	for v := 0; v < 10; v++ {
+		assert.ElementsMatch([]int{(v + 1) % 10, (v + 2) % 10, (v + 9) % 10, (v + 8) % 10}, G.Adj(v))
+	}
+	for _, p := range []float64{0.1, 1} {
+		G, err = generator.WattsStrogatz(200, 6, p)
+		assert.Nil(err)
+		assert.Equal(600, G.E())
+		finder := NewCycle(G)
+		assert.False(finder.hasParallelEdges(G))
+		assert.False(finder.hasSelfLoop(G))
+	}
+	// every vertex is already joined to all others
+	G, err = generator.WattsStrogatz(5, 4, 1)
+	assert.Nil(err)
+	assert.Equal(10, G.E())
+
+	_, err = generator.WattsStrogatz(10, 3, 0.5)
+	assert.Error(err)
+	_, err = generator.WattsStrogatz(10, 10, 0.5)
+	assert.Error(err)
+	_, err = generator.WattsStrogatz(10, 4, 1.5)
+	assert.Error(err)
+}
+
+func TestGraphGenerator_RMATKronecker(t *testing.T) {
+	generator := NewGraphGeneratorSeed(42)
+	assert := assert.New(t)
+	G, err := generator.RMAT(8, 1000, 0.57, 0.19, 0.19)
+	assert.Nil(err)
+	assert.Equal(256, G.V())
+	assert.Equal(1000, G.E())
+	finder := NewCycle(G)
+	assert.False(finder.hasParallelEdges(G))
+	assert.False(finder.hasSelfLoop(G))
+	// the top-left quadrant attracts the edges to vertex 0
+	assert.True(G.Degree(0) > G.Degree(255))
+
+	_, err = generator.RMAT(2, 7, 0.25, 0.25, 0.25)
+	assert.EqualError(err, "too many edges")
+	_, err = generator.RMAT(2, 3, 0.5, 0.5, 0.5)
+	assert.Error(err)
+	// without the bottom quadrants every edge is incident on vertex 0, so only the star is reachable
+	_, err = generator.RMAT(3, 8, 0.5, 0.5, 0)
+	assert.EqualError(err, "too many edges for the probabilities")
+	G, err = generator.RMAT(3, 7, 0.5, 0.5, 0)
+	assert.Nil(err)
+	assert.Equal(7, G.Degree(0))
+	// only self-loops
+	_, err = generator.RMAT(3, 1, 1, 0, 0)
+	assert.EqualError(err, "too many edges for the probabilities")
+	// only the off-diagonal quadrants join every vertex to its complement
+	G, err = generator.RMAT(3, 4, 0, 0.5, 0.5)
+	assert.Nil(err)
+	assert.Equal([]int{7}, G.Adj(0))
+	_, err = generator.RMAT(3, 5, 0, 0.5, 0.5)
+	assert.Error(err)
+	// probabilities summing to 1 up to rounding leave the bottom right quadrant unreachable
+	_, err = generator.RMAT(3, 14, 0.6, 0.3, 0.1)
+	assert.EqualError(err, "too many edges for the probabilities")
+	G, err = generator.RMAT(3, 13, 0.6, 0.3, 0.1)
+	assert.Nil(err)
+	assert.Equal(13, G.E())
+
+	// an initiator of ones gives the complete graph
+	G, err = generator.Kronecker([][]float64{{1, 1}, {1, 1}}, 3)
+	assert.Nil(err)
+	assert.Equal(8, G.V())
+	assert.Equal(28, G.E())
+	// a diagonal initiator joins only equal digits, so no distinct vertices
+	G, err = generator.Kronecker([][]float64{{1, 0}, {0, 1}}, 3)
+	assert.Nil(err)
+	assert.Equal(0, G.E())
+	G, err = generator.Kronecker([][]float64{{0.9, 0.5}, {0.5, 0.1}}, 6)
+	assert.Nil(err)
+	assert.Equal(64, G.V())
+
+	_, err = generator.Kronecker([][]float64{{0.9, 0.5}, {0.4, 0.1}}, 2)
+	assert.EqualError(err, "initiator must be symmetric")
+	_, err = generator.Kronecker([][]float64{{0.9, 0.5}}, 2)
+	assert.EqualError(err, "initiator must be square")
+	_, err = generator.Kronecker(nil, 2)
+	assert.Error(err)
+}
+
+func TestGraphGenerator_ConfigurationModel(t *testing.T) {
+	generator := NewGraphGeneratorSeed(42)
+	assert := assert.New(t)
+	degrees := []int{3, 1, 4, 1, 5, 2, 6, 0}
+	G, err := generator.ConfigurationModel(degrees)
+	assert.Nil(err)
+	assert.Equal(11, G.E())
+	for v, d := range degrees {
+		assert.Equal(d, G.Degree(v))
+	}
+
+	_, err = generator.ConfigurationModel([]int{1, 2})
+	assert.EqualError(err, "sum of degrees must be even")
+	_, err = generator.ConfigurationModel([]int{3, -1})
+	assert.Error(err)
+}
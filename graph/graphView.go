@@ -0,0 +1,11 @@
+package graph
+
+// GraphView is the read-only view of a graph of vertices named 0 through V - 1 that the search, path,
+// connected-component, cycle and bipartite types need: the number of vertices and the vertices adjacent to each,
+// with both ends of every edge and a self loop twice in the list of its vertex, as Graph has them.
+// Adj panics if the vertex is not between 0 and V - 1. Graph implements it, and so can other representations,
+// such as implicit grids or adjacency stored in a database, to run these algorithms without copying into a Graph.
+type GraphView interface {
+	V() int
+	Adj(v int) []int
+}
@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cycleView is an implicit cycle graph on n vertices.
+type cycleView struct{ n int }
+
+func (c cycleView) V() int { return c.n }
+
+func (c cycleView) Adj(v int) []int { return []int{(v + 1) % c.n, (v + c.n - 1) % c.n} }
+
+func TestGraphView(t *testing.T) {
+	assert := assert.New(t)
+	var _ GraphView = (*Graph)(nil)
+
+	even, odd := cycleView{n: 10}, cycleView{n: 9}
+	assert.Equal(5, NewBreadthFirstPaths(even, 0).DistTo(5))
+	assert.Equal(1, NewCC(even).Count())
+	assert.True(NewCycle(even).HasCycle())
+	assert.True(NewBipartite(even).IsBipartite())
+	assert.False(NewBipartite(odd).IsBipartite())
+	assert.Equal([]int{0, 1, 2, 3}, NewDepthFirstPaths(odd, 0).PathTo(3))
+	assert.Equal(9, NewDepthFirstSearch(odd, 4).Count())
+}
@@ -76,3 +76,131 @@ func TestGraph_String(t *testing.T) {
 	g.AddEdge(0, 2)
 	assert.Equal("3 vertices, 2 edges \n"+"0:  2 1\n"+"1:  0\n"+"2:  0\n", g.String())
 }
+
+func TestGraph_RemoveEdge(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 2)
+
+	assert.True(G.RemoveEdge(1, 0))
+	assert.False(G.RemoveEdge(0, 2))
+	assert.True(G.RemoveEdge(2, 2))
+	assert.False(G.RemoveEdge(2, 2))
+	assert.Equal(2, G.E())
+	assert.Equal([]int{1}, G.Adj(0))
+	assert.ElementsMatch([]int{0, 2}, G.Adj(1))
+	assert.Equal(1, G.Degree(2))
+}
+
+func TestGraph_RemoveVertex(t *testing.T) {
+	assert := assert.New(t)
+	// peel the leaves of a tree with a cycle until only the cycle remains
+	G := NewGraph(7)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {1, 5}, {6, 6}} {
+		G.AddEdge(e[0], e[1])
+	}
+	G.RemoveVertex(6)
+	for peeled := true; peeled; {
+		peeled = false
+		for v := 0; v < G.V(); v++ {
+			if G.Degree(v) <= 1 {
+				G.RemoveVertex(v)
+				peeled = true
+				break
+			}
+		}
+	}
+	assert.Equal(3, G.V())
+	assert.Equal(3, G.E())
+	for v := 0; v < 3; v++ {
+		assert.Equal(2, G.Degree(v))
+	}
+	assert.Panics(func() { G.RemoveVertex(3) })
+}
+
+func TestGraph_RemoveVertices(t *testing.T) {
+	assert := assert.New(t)
+	// peel the 2-core of a triangle with trees and an isolated vertex, a round of leaves per call
+	G := NewGraph(8)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {1, 6}} {
+		G.AddEdge(e[0], e[1])
+	}
+	original := make([]int, G.V()) // original[v] = vertex of the first graph that v is now
+	for v := range original {
+		original[v] = v
+	}
+	rounds := 0
+	for {
+		var leaves []int
+		for v := 0; v < G.V(); v++ {
+			if G.Degree(v) <= 1 {
+				leaves = append(leaves, v)
+			}
+		}
+		if len(leaves) == 0 {
+			break
+		}
+		index := G.RemoveVertices(leaves)
+		for v, i := range index {
+			if i >= 0 {
+				original[i] = original[v]
+			}
+		}
+		original = original[:G.V()]
+		rounds++
+	}
+	assert.Equal(3, rounds)
+	assert.Equal([]int{0, 1, 2}, original)
+	assert.Equal(3, G.E())
+
+	G = NewGraph(4)
+	G.AddEdge(0, 3)
+	G.AddEdge(1, 3)
+	assert.Equal([]int{-1, 0, -1, 1}, G.RemoveVertices([]int{2, 0}))
+	assert.Equal(1, G.E())
+	assert.Equal([]int{1}, G.Adj(0))
+	assert.Equal([]int{0, 1}, G.RemoveVertices(nil))
+	assert.Panics(func() { G.RemoveVertices([]int{1, 1}) })
+	assert.Panics(func() { G.RemoveVertices([]int{2}) })
+}
+
+func TestGraph_Contract(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 3)
+	G.AddEdge(3, 0)
+	G.AddEdge(1, 3)
+
+	v := G.Contract(3, 1)
+	assert.Equal(2, v)
+	assert.Equal(3, G.V())
+	assert.Equal(4, G.E())
+	assert.ElementsMatch([]int{0, 0, 1, 1}, G.Adj(v))
+	assert.ElementsMatch([]int{2, 2}, G.Adj(0))
+	assert.Panics(func() { G.Contract(1, 1) })
+}
+
+func TestGraph_InducedSubgraph(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(5)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 3)
+	G.AddEdge(3, 4)
+	G.AddEdge(4, 4)
+	G.AddEdge(1, 3)
+
+	H, index := G.InducedSubgraph([]int{3, 1, 4})
+	assert.Equal(3, H.V())
+	assert.Equal(3, H.E())
+	assert.Equal([]int{-1, 1, -1, 0, 2}, index)
+	assert.ElementsMatch([]int{1, 2}, H.Adj(0))
+	assert.ElementsMatch([]int{2, 2, 0}, H.Adj(2))
+	assert.Equal(6, G.E())
+	assert.Panics(func() { G.InducedSubgraph([]int{1, 1}) })
+}
@@ -0,0 +1,46 @@
+package graph
+
+// HamiltonianCycle struct represents a data type for finding a Hamiltonian cycle in a graph.
+// A Hamiltonian cycle is a cycle that visits every vertex exactly once; it has at least three vertices,
+// so self-loops and parallel edges play no part.
+// This implementation uses backtracking: it extends a path from vertex 0, trying the neighbors with the fewest
+// unvisited neighbors first (Warnsdorff's rule), and backtracks as soon as an unvisited vertex is left with fewer
+// than two ways in and out. The constructor takes exponential time in the worst case and is intended for small
+// graphs. Each instance method takes O(1) time, except Cycle, which takes O(V) time.
+// It uses O(V^2) extra space (not including the graph).
+type HamiltonianCycle struct {
+	cycle []int // Hamiltonian cycle, starting and ending at vertex 0; nil if no such cycle
+}
+
+// NewHamiltonianCycle computes a Hamiltonian cycle in the specified graph, if one exists.
+func NewHamiltonianCycle(G *Graph) *HamiltonianCycle {
+	hc := &HamiltonianCycle{}
+	if G.V() < 3 || NewCC(G).Count() > 1 {
+		return hc
+	}
+	h := newHamiltonianSearch(G, true)
+	for v := range h.adj {
+		if len(h.adj[v]) < 2 {
+			return hc
+		}
+	}
+	if h.search(0) {
+		hc.cycle = append(h.path, 0)
+	}
+	return hc
+}
+
+// Cycle returns the sequence of vertices on a Hamiltonian cycle, with vertex 0 at both ends.
+func (hc *HamiltonianCycle) Cycle() (c []int) {
+	if !hc.HasHamiltonianCycle() {
+		return nil
+	}
+	c = make([]int, len(hc.cycle))
+	copy(c, hc.cycle)
+	return c
+}
+
+// HasHamiltonianCycle returns true if the graph has a Hamiltonian cycle.
+func (hc *HamiltonianCycle) HasHamiltonianCycle() bool {
+	return hc.cycle != nil
+}
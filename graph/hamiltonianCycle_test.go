@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHamiltonianCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	petersen := NewGraph(10)
+	for i := 0; i < 5; i++ {
+		petersen.AddEdge(i, (i+1)%5)
+		petersen.AddEdge(i, i+5)
+		petersen.AddEdge(i+5, (i+2)%5+5)
+	}
+	assert.False(NewHamiltonianCycle(petersen).HasHamiltonianCycle())
+	assert.Nil(NewHamiltonianCycle(petersen).Cycle())
+
+	// the dodecahedron, Hamilton's original puzzle
+	dodecahedron := NewGraph(20)
+	for i := 0; i < 5; i++ {
+		dodecahedron.AddEdge(i, (i+1)%5)
+		dodecahedron.AddEdge(i, i+5)
+		dodecahedron.AddEdge(i+5, i+10)
+		dodecahedron.AddEdge(i+10, (i+4)%5+5)
+		dodecahedron.AddEdge(i+10, i+15)
+		dodecahedron.AddEdge(i+15, (i+1)%5+15)
+	}
+	hc := NewHamiltonianCycle(dodecahedron)
+	assert.True(hc.HasHamiltonianCycle())
+	assertHamiltonian(assert, dodecahedron, hc.Cycle(), true)
+	assert.Equal(0, hc.Cycle()[0])
+
+	// two vertices joined by parallel edges do not form a Hamiltonian cycle
+	pair := NewGraph(2)
+	pair.AddEdge(0, 1)
+	pair.AddEdge(0, 1)
+	assert.False(NewHamiltonianCycle(pair).HasHamiltonianCycle())
+
+	triangle := NewGraph(3)
+	triangle.AddEdge(0, 1)
+	triangle.AddEdge(1, 2)
+	triangle.AddEdge(2, 0)
+	assert.Equal(4, len(NewHamiltonianCycle(triangle).Cycle()))
+}
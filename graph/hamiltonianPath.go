@@ -0,0 +1,170 @@
+package graph
+
+import "sort"
+
+// HamiltonianPath struct represents a data type for finding a Hamiltonian path in a graph.
+// A Hamiltonian path is a path that visits every vertex exactly once.
+// This implementation uses backtracking: it extends the path from its last vertex, trying the neighbors with
+// the fewest unvisited neighbors first (Warnsdorff's rule), and backtracks as soon as an unvisited vertex can no
+// longer be reached. The constructor takes exponential time in the worst case and is intended for small graphs.
+// Each instance method takes O(1) time, except Path, which takes O(V) time.
+// It uses O(V^2) extra space (not including the graph).
+type HamiltonianPath struct {
+	path []int // Hamiltonian path; nil if no such path
+}
+
+// NewHamiltonianPath computes a Hamiltonian path in the specified graph, if one exists.
+func NewHamiltonianPath(G *Graph) *HamiltonianPath {
+	hp := &HamiltonianPath{}
+	V := G.V()
+	if V == 0 || NewCC(G).Count() > 1 {
+		return hp
+	}
+	h := newHamiltonianSearch(G, false)
+	// a vertex with a single neighbor must be an end of the path, so the path can start there
+	var ends []int
+	for v := 0; v < V; v++ {
+		if len(h.adj[v]) == 1 {
+			ends = append(ends, v)
+		}
+	}
+	if len(ends) > 2 {
+		return hp
+	}
+	starts := ends
+	if len(ends) > 0 {
+		starts = ends[:1]
+	} else {
+		for v := 0; v < V; v++ {
+			starts = append(starts, v)
+		}
+	}
+	for _, s := range starts {
+		if h.search(s) {
+			hp.path = h.path
+			break
+		}
+	}
+	return hp
+}
+
+// Path returns the sequence of vertices on a Hamiltonian path.
+func (hp *HamiltonianPath) Path() (p []int) {
+	if !hp.HasHamiltonianPath() {
+		return nil
+	}
+	p = make([]int, len(hp.path))
+	copy(p, hp.path)
+	return p
+}
+
+// HasHamiltonianPath returns true if the graph has a Hamiltonian path.
+func (hp *HamiltonianPath) HasHamiltonianPath() bool {
+	return hp.path != nil
+}
+
+// hamiltonianSearch holds the state of the backtracking search for a Hamiltonian path or cycle.
+type hamiltonianSearch struct {
+	adj       [][]int  // distinct neighbors of every vertex
+	adjacent  [][]bool // adjacent[v][w] = are v and w adjacent?
+	unvisited []int    // unvisited[v] = number of unvisited neighbors of v
+	visited   []bool
+	path      []int
+	cycle     bool // must the path close into a cycle?
+}
+
+func newHamiltonianSearch(G *Graph, cycle bool) *hamiltonianSearch {
+	V := G.V()
+	h := &hamiltonianSearch{
+		adj:       distinctNeighbors(G),
+		adjacent:  make([][]bool, V),
+		unvisited: make([]int, V),
+		visited:   make([]bool, V),
+		cycle:     cycle,
+	}
+	for v := 0; v < V; v++ {
+		h.adjacent[v] = make([]bool, V)
+		for _, w := range h.adj[v] {
+			h.adjacent[v][w] = true
+		}
+		h.unvisited[v] = len(h.adj[v])
+	}
+	return h
+}
+
+// searches for a Hamiltonian path (or cycle) starting at s
+func (h *hamiltonianSearch) search(s int) bool {
+	h.path = h.path[:0]
+	h.visit(s)
+	if h.extend() {
+		return true
+	}
+	h.leave(s)
+	return false
+}
+
+func (h *hamiltonianSearch) visit(v int) {
+	h.visited[v] = true
+	h.path = append(h.path, v)
+	for _, w := range h.adj[v] {
+		h.unvisited[w]--
+	}
+}
+
+func (h *hamiltonianSearch) leave(v int) {
+	h.visited[v] = false
+	h.path = h.path[:len(h.path)-1]
+	for _, w := range h.adj[v] {
+		h.unvisited[w]++
+	}
+}
+
+// extends the path from its last vertex; returns true if it was completed
+func (h *hamiltonianSearch) extend() bool {
+	v := h.path[len(h.path)-1]
+	if len(h.path) == len(h.adj) {
+		return !h.cycle || h.adjacent[v][h.path[0]]
+	}
+
+	var candidates []int
+	for _, w := range h.adj[v] {
+		if !h.visited[w] {
+			candidates = append(candidates, w)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return h.unvisited[candidates[i]] < h.unvisited[candidates[j]] })
+	for _, w := range candidates {
+		h.visit(w)
+		if h.reachable(v, w) && h.extend() {
+			return true
+		}
+		h.leave(w)
+	}
+	return false
+}
+
+// after moving the end of the path from v to w, can every unvisited neighbor of v still be entered and,
+// unless it is the last vertex of a path, left again?
+func (h *hamiltonianSearch) reachable(v, w int) bool {
+	start := h.path[0]
+	need := 1
+	if h.cycle {
+		need = 2
+	}
+	for _, x := range h.adj[v] {
+		if h.visited[x] {
+			continue
+		}
+		free := h.unvisited[x]
+		if h.adjacent[x][w] {
+			free++
+		}
+		if h.cycle && h.adjacent[x][start] {
+			free++
+		}
+		if free < need {
+			return false
+		}
+	}
+	return true
+}
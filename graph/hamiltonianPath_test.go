@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHamiltonianPath(t *testing.T) {
+	assert := assert.New(t)
+
+	// the Petersen graph has a Hamiltonian path but no Hamiltonian cycle
+	petersen := NewGraph(10)
+	for i := 0; i < 5; i++ {
+		petersen.AddEdge(i, (i+1)%5)
+		petersen.AddEdge(i, i+5)
+		petersen.AddEdge(i+5, (i+2)%5+5)
+	}
+	hp := NewHamiltonianPath(petersen)
+	assert.True(hp.HasHamiltonianPath())
+	assertHamiltonian(assert, petersen, hp.Path(), false)
+
+	star := NewGraph(4)
+	for i := 1; i < 4; i++ {
+		star.AddEdge(0, i)
+	}
+	assert.False(NewHamiltonianPath(star).HasHamiltonianPath())
+	assert.Nil(NewHamiltonianPath(star).Path())
+
+	// a path with a self-loop and a parallel edge, numbered out of order
+	path := NewGraph(4)
+	path.AddEdge(2, 0)
+	path.AddEdge(0, 3)
+	path.AddEdge(3, 1)
+	path.AddEdge(3, 3)
+	path.AddEdge(0, 3)
+	assert.Equal([]int{1, 3, 0, 2}, NewHamiltonianPath(path).Path())
+
+	single := NewHamiltonianPath(NewGraph(1))
+	assert.Equal([]int{0}, single.Path())
+	assert.False(NewHamiltonianPath(NewGraph(0)).HasHamiltonianPath())
+	assert.False(NewHamiltonianPath(NewGraph(2)).HasHamiltonianPath())
+}
+
+func TestHamiltonianRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 200; trial++ {
+		V := 3 + r.Intn(5)
+		g := NewGraph(V)
+		for v := 0; v < V; v++ {
+			for w := v + 1; w < V; w++ {
+				if r.Float64() < 0.4 {
+					g.AddEdge(v, w)
+				}
+			}
+		}
+		hasPath, hasCycle := bruteForceHamiltonian(g)
+		hp := NewHamiltonianPath(g)
+		assert.Equal(hasPath, hp.HasHamiltonianPath())
+		if hasPath {
+			assertHamiltonian(assert, g, hp.Path(), false)
+		}
+		hc := NewHamiltonianCycle(g)
+		assert.Equal(hasCycle, hc.HasHamiltonianCycle())
+		if hasCycle {
+			assertHamiltonian(assert, g, hc.Cycle(), true)
+		}
+	}
+}
+
+// checks that p visits every vertex once along edges of G, returning to its start if it is a cycle
+func assertHamiltonian(assert *assert.Assertions, G *Graph, p []int, cycle bool) {
+	vertices := p
+	if cycle {
+		assert.Equal(p[0], p[len(p)-1])
+		vertices = p[:len(p)-1]
+	}
+	assert.Equal(G.V(), len(vertices))
+	seen := make(map[int]bool)
+	for _, v := range vertices {
+		assert.False(seen[v])
+		seen[v] = true
+	}
+	for i := 1; i < len(p); i++ {
+		assert.Contains(G.Adj(p[i-1]), p[i])
+	}
+}
+
+// tries every order of the vertices
+func bruteForceHamiltonian(G *Graph) (hasPath, hasCycle bool) {
+	V := G.V()
+	adjacent := make([][]bool, V)
+	for v := range adjacent {
+		adjacent[v] = make([]bool, V)
+		for _, w := range G.Adj(v) {
+			adjacent[v][w] = true
+		}
+	}
+	order := make([]int, V)
+	for v := range order {
+		order[v] = v
+	}
+	var permute func(k int)
+	permute = func(k int) {
+		if k == V {
+			for i := 1; i < V; i++ {
+				if !adjacent[order[i-1]][order[i]] {
+					return
+				}
+			}
+			hasPath = true
+			if V >= 3 && adjacent[order[V-1]][order[0]] {
+				hasCycle = true
+			}
+			return
+		}
+		for i := k; i < V; i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(k + 1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+	permute(0)
+	return hasPath, hasCycle
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/handane123/algorithms/internal/vf2"
+)
+
+// Isomorphism struct represents a data type for matching the vertices of a pattern graph to those of a target
+// graph. An isomorphism is a bijection between the vertices of two graphs that preserves adjacency, with the
+// same number of parallel edges and self-loops between corresponding vertices. A subgraph isomorphism maps the
+// pattern one-to-one onto some vertices of the target so that every edge of the pattern is present in the
+// target; an induced subgraph isomorphism also requires that no other edges join the matched target vertices.
+// An optional compatibility function restricts which target vertices each pattern vertex may be matched to,
+// for instance vertices with the same label (see LabelsByName).
+// This implementation uses the VF2 algorithm: it extends a partial matching one pattern vertex at a time,
+// visiting the pattern in breadth-first order from vertices of high degree, trying only the target neighbors
+// of an already matched neighbor, and pruning pairs whose numbers of matched, frontier and unexplored neighbors
+// cannot be reconciled. It takes exponential time in the worst case but is fast on most graphs.
+// Each instance method takes O(1) time, except Mapping, which takes O(V) time.
+// It uses O(V + E) extra space (not including the graphs).
+type Isomorphism struct {
+	mapping []int // mapping[v] = target vertex matched to pattern vertex v, nil if there is no match
+}
+
+// NewIsomorphism finds an isomorphism from the graph G to the graph H that matches only compatible vertices;
+// compatible may be nil to allow every pair.
+func NewIsomorphism(G, H *Graph, compatible func(v, w int) bool) *Isomorphism {
+	if G.V() != H.V() || G.E() != H.E() {
+		return &Isomorphism{}
+	}
+	return newIsomorphism(G, H, compatible, vf2.Isomorphism)
+}
+
+// NewSubgraphIsomorphism finds a subgraph isomorphism, or an induced subgraph isomorphism if induced is true,
+// from the pattern graph to the target graph that matches only compatible vertices;
+// compatible may be nil to allow every pair.
+func NewSubgraphIsomorphism(pattern, target *Graph, induced bool, compatible func(v, w int) bool) *Isomorphism {
+	if pattern.V() > target.V() || pattern.E() > target.E() {
+		return &Isomorphism{}
+	}
+	if induced {
+		return newIsomorphism(pattern, target, compatible, vf2.InducedSubgraph)
+	}
+	return newIsomorphism(pattern, target, compatible, vf2.Subgraph)
+}
+
+func newIsomorphism(pattern, target *Graph, compatible func(v, w int) bool, kind vf2.Kind) *Isomorphism {
+	return &Isomorphism{mapping: vf2.Match(multiplicities(pattern), multiplicities(target), kind, compatible)}
+}
+
+// returns the adjacency of G for matching: the distinct neighbors of every vertex in ascending order and the
+// number of edges between every pair of adjacent vertices, a self-loop counted twice
+func multiplicities(G *Graph) *vf2.Graph {
+	count := make([]map[int]int, G.V())
+	for v := range count {
+		count[v] = make(map[int]int)
+		for _, w := range G.Adj(v) {
+			count[v][w]++
+		}
+	}
+	return &vf2.Graph{Neighbors: distinctNeighbors(G), Count: count}
+}
+
+// LabelsByName returns a compatibility function that matches vertex v of the symbol graph G to vertex w of
+// the symbol graph H if their names have the same label; a nil label compares the names themselves.
+func LabelsByName(G, H *SymbolGraph, label func(name string) string) func(v, w int) bool {
+	if label == nil {
+		label = func(name string) string { return name }
+	}
+	return func(v, w int) bool {
+		return label(G.NameOf(v)) == label(H.NameOf(w))
+	}
+}
+
+// Found returns true if a matching was found.
+func (iso *Isomorphism) Found() bool {
+	return iso.mapping != nil
+}
+
+// Map returns the target vertex matched to the pattern vertex v.
+func (iso *Isomorphism) Map(v int) int {
+	if iso.mapping == nil {
+		panic("no matching was found")
+	}
+	iso.validateVertex(v)
+	return iso.mapping[v]
+}
+
+// Mapping returns the target vertex matched to every pattern vertex, or nil if no matching was found.
+func (iso *Isomorphism) Mapping() (mapping []int) {
+	if iso.mapping == nil {
+		return nil
+	}
+	mapping = make([]int, len(iso.mapping))
+	copy(mapping, iso.mapping)
+	return mapping
+}
+
+func (iso *Isomorphism) validateVertex(v int) {
+	V := len(iso.mapping)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/handane123/algorithms/internal/vf2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsomorphism(t *testing.T) {
+	assert := assert.New(t)
+
+	// a 6-cycle and two triangles have the same degrees but are not isomorphic
+	cycle := NewGraph(6)
+	triangles := NewGraph(6)
+	for i := 0; i < 6; i++ {
+		cycle.AddEdge(i, (i+1)%6)
+		triangles.AddEdge(i, i/3*3+(i+1)%3)
+	}
+	assert.False(NewIsomorphism(cycle, triangles, nil).Found())
+	assert.Nil(NewIsomorphism(cycle, triangles, nil).Mapping())
+	assert.Panics(func() { NewIsomorphism(cycle, triangles, nil).Map(0) })
+
+	shuffled := NewGraph(6)
+	for _, e := range [][2]int{{3, 5}, {5, 0}, {0, 2}, {2, 4}, {4, 1}, {1, 3}} {
+		shuffled.AddEdge(e[0], e[1])
+	}
+	iso := NewIsomorphism(cycle, shuffled, nil)
+	assert.True(iso.Found())
+	assertMapping(assert, cycle, shuffled, iso.Mapping(), vf2.Isomorphism)
+	assert.Panics(func() { iso.Map(6) })
+
+	// parallel edges and self-loops must correspond
+	a := NewGraph(2)
+	a.AddEdge(0, 1)
+	a.AddEdge(0, 1)
+	a.AddEdge(1, 1)
+	b := NewGraph(2)
+	b.AddEdge(1, 0)
+	b.AddEdge(0, 0)
+	b.AddEdge(0, 1)
+	iso = NewIsomorphism(a, b, nil)
+	assert.Equal([]int{1, 0}, iso.Mapping())
+	b = NewGraph(2)
+	b.AddEdge(1, 0)
+	b.AddEdge(0, 0)
+	b.AddEdge(1, 1)
+	assert.False(NewIsomorphism(a, b, nil).Found())
+
+	assert.True(NewIsomorphism(NewGraph(0), NewGraph(0), nil).Found())
+}
+
+func TestSubgraphIsomorphism(t *testing.T) {
+	assert := assert.New(t)
+
+	k4 := NewGraph(4)
+	for v := 0; v < 4; v++ {
+		for w := v + 1; w < 4; w++ {
+			k4.AddEdge(v, w)
+		}
+	}
+	path := NewGraph(3)
+	path.AddEdge(0, 1)
+	path.AddEdge(1, 2)
+	sub := NewSubgraphIsomorphism(path, k4, false, nil)
+	assert.True(sub.Found())
+	assertMapping(assert, path, k4, sub.Mapping(), vf2.Subgraph)
+	assert.False(NewSubgraphIsomorphism(path, k4, true, nil).Found())
+
+	// vertex 0 of the path may only go to vertex 3
+	compatible := func(v, w int) bool { return v != 0 || w == 3 }
+	assert.Equal(3, NewSubgraphIsomorphism(path, k4, false, compatible).Map(0))
+	assert.False(NewSubgraphIsomorphism(k4, path, false, nil).Found())
+}
+
+func TestSubgraphIsomorphismRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(6))
+	for trial := 0; trial < 50; trial++ {
+		V := 30
+		target := NewGraph(V)
+		for i := 0; i < 60; i++ {
+			target.AddEdge(r.Intn(V), r.Intn(V))
+		}
+
+		// the subgraph induced by random vertices, renumbered
+		vertices := r.Perm(V)[:10]
+		index := make(map[int]int)
+		for i, v := range vertices {
+			index[v] = i
+		}
+		pattern := NewGraph(len(vertices))
+		for _, e := range edgesOf(target) {
+			i, iok := index[e[0]]
+			j, jok := index[e[1]]
+			if iok && jok {
+				pattern.AddEdge(i, j)
+			}
+		}
+
+		induced := NewSubgraphIsomorphism(pattern, target, true, nil)
+		assert.True(induced.Found())
+		assertMapping(assert, pattern, target, induced.Mapping(), vf2.InducedSubgraph)
+		sub := NewSubgraphIsomorphism(pattern, target, false, nil)
+		assert.True(sub.Found())
+		assertMapping(assert, pattern, target, sub.Mapping(), vf2.Subgraph)
+
+		// a shuffled copy is isomorphic and has the same hash
+		perm := r.Perm(V)
+		copied := NewGraph(V)
+		for _, e := range edgesOf(target) {
+			copied.AddEdge(perm[e[0]], perm[e[1]])
+		}
+		iso := NewIsomorphism(target, copied, nil)
+		assert.True(iso.Found())
+		assertMapping(assert, target, copied, iso.Mapping(), vf2.Isomorphism)
+		assert.Equal(WeisfeilerLehmanHash(target, 3, nil), WeisfeilerLehmanHash(copied, 3, nil))
+	}
+}
+
+// returns every edge of G once; a self-loop appears twice in the adjacency list of its vertex
+func edgesOf(G *Graph) (edges [][2]int) {
+	for v := 0; v < G.V(); v++ {
+		loops := 0
+		for _, w := range G.Adj(v) {
+			if w == v {
+				loops++
+			}
+			if v < w || w == v && loops%2 == 1 {
+				edges = append(edges, [2]int{v, w})
+			}
+		}
+	}
+	return edges
+}
+
+func TestLabelsByName(t *testing.T) {
+	assert := assert.New(t)
+	// two molecules: ethanol C-C-O written in different orders, and its isomer dimethyl ether C-O-C
+	ethanol := newTestSymbolGraph(t, "C1 C2\nC2 O1\n")
+	reversed := newTestSymbolGraph(t, "O7 C8\nC8 C9\n")
+	ether := newTestSymbolGraph(t, "C1 O1\nO1 C2\n")
+	element := func(name string) string { return strings.TrimRight(name, "0123456789") }
+
+	iso := NewIsomorphism(ethanol.Graph(), reversed.Graph(), LabelsByName(ethanol, reversed, element))
+	assert.True(iso.Found())
+	assert.Equal("O7", reversed.NameOf(iso.Map(ethanol.IndexOf("O1"))))
+	assert.False(NewIsomorphism(ethanol.Graph(), ether.Graph(), LabelsByName(ethanol, ether, element)).Found())
+	assert.True(NewIsomorphism(ethanol.Graph(), ether.Graph(), nil).Found())
+	assert.False(NewIsomorphism(ethanol.Graph(), reversed.Graph(), LabelsByName(ethanol, reversed, nil)).Found())
+
+	label := func(sg *SymbolGraph) func(v int) string {
+		return func(v int) string { return element(sg.NameOf(v)) }
+	}
+	assert.Equal(WeisfeilerLehmanHash(ethanol.Graph(), 2, label(ethanol)),
+		WeisfeilerLehmanHash(reversed.Graph(), 2, label(reversed)))
+	assert.NotEqual(WeisfeilerLehmanHash(ethanol.Graph(), 2, label(ethanol)),
+		WeisfeilerLehmanHash(ether.Graph(), 2, label(ether)))
+}
+
+func newTestSymbolGraph(t *testing.T, content string) *SymbolGraph {
+	tmpfile, err := ioutil.TempFile("", "symbols.*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte(content)); err != nil {
+		tmpfile.Close()
+		t.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return NewSymbolGraph(tmpfile.Name(), " ")
+}
+
+// checks that mapping is one-to-one and preserves the edge counts between every pair of pattern vertices
+func assertMapping(assert *assert.Assertions, pattern, target *Graph, mapping []int, kind vf2.Kind) {
+	countP := multiplicities(pattern).Count
+	countT := multiplicities(target).Count
+	used := make(map[int]bool)
+	for _, x := range mapping {
+		assert.False(used[x])
+		used[x] = true
+	}
+	for v := range mapping {
+		for w := range mapping {
+			p, t := countP[v][w], countT[mapping[v]][mapping[w]]
+			if kind == vf2.Subgraph {
+				assert.LessOrEqual(p, t)
+			} else {
+				assert.Equal(p, t)
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// maximum number of passes over the vertices before label propagation gives up on convergence
+const labelPropagationMaxPasses = 1000
+
+// LabelPropagation struct represents a data type for detecting communities in an undirected graph.
+// Every vertex starts with a label of its own; vertices are then visited in random order and each adopts
+// the label that is most frequent among its neighbors (keeping its own label if that is among the most
+// frequent, and otherwise breaking ties at random), until every vertex agrees with its neighborhood.
+// Vertices sharing a label form a community. The communities are numbered 0 through Count() - 1
+// in order of their smallest vertex.
+// The random choices are drawn from a generator seeded by the caller, so equal seeds give equal results.
+// Each pass over the vertices takes O(V + E) time, where V is the number of vertices and E is the number
+// of edges; in practice few passes are needed. Each instance method takes O(1) time.
+// It uses O(V) extra space (not including the graph).
+type LabelPropagation struct {
+	community  []int   // community[v] = community of vertex v
+	count      int     // number of communities
+	modularity float64 // modularity of the partition
+	passes     int     // number of passes over the vertices
+}
+
+// NewLabelPropagation computes a partition of the graph G into communities, drawing the random choices
+// from a generator with the given seed.
+func NewLabelPropagation(G *Graph, seed int64) *LabelPropagation {
+	lp := &LabelPropagation{community: make([]int, G.V())}
+	adj := make([][]int, G.V())
+	for v := range lp.community {
+		lp.community[v] = v
+		adj[v] = G.Adj(v)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	count := make([]int, G.V()) // count[l] = number of neighbors of the current vertex labeled l
+	var labels, best []int
+	for changed := true; changed && lp.passes < labelPropagationMaxPasses; {
+		changed = false
+		lp.passes++
+		for _, v := range r.Perm(G.V()) {
+			if len(adj[v]) == 0 {
+				continue
+			}
+			labels = labels[:0]
+			max := 0
+			for _, w := range adj[v] {
+				l := lp.community[w]
+				if count[l] == 0 {
+					labels = append(labels, l)
+				}
+				count[l]++
+				if count[l] > max {
+					max = count[l]
+				}
+			}
+			best = best[:0]
+			for _, l := range labels {
+				if count[l] == max {
+					best = append(best, l)
+				}
+			}
+			if count[lp.community[v]] != max {
+				lp.community[v] = best[r.Intn(len(best))]
+				changed = true
+			}
+			for _, l := range labels {
+				count[l] = 0
+			}
+		}
+	}
+
+	lp.count = renumber(lp.community)
+	lp.modularity = Modularity(G, lp.community)
+	return lp
+}
+
+// Modularity returns the modularity of the partition of the graph G given by community, where community[v]
+// is the community of vertex v: the fraction of the edges that fall inside communities, minus the fraction
+// expected if the edges were rewired at random keeping every vertex's degree.
+func Modularity(G *Graph, community []int) float64 {
+	if len(community) != G.V() {
+		panic("number of labels does not match the number of vertices")
+	}
+	if G.E() == 0 {
+		return 0
+	}
+	labels := make([]int, G.V())
+	copy(labels, community)
+	count := renumber(labels)
+	inside := make([]float64, count)
+	degree := make([]float64, count)
+	for v := 0; v < G.V(); v++ {
+		c := labels[v]
+		degree[c] += float64(G.Degree(v))
+		for _, w := range G.Adj(v) {
+			if labels[w] == c {
+				inside[c]++
+			}
+		}
+	}
+	m2 := float64(2 * G.E())
+	q := 0.0
+	for c := range degree {
+		q += inside[c]/m2 - (degree[c]/m2)*(degree[c]/m2)
+	}
+	return q
+}
+
+// renumbers the labels in order of first appearance and returns the number of distinct labels
+func renumber(labels []int) int {
+	id := make(map[int]int)
+	for v, l := range labels {
+		c, ok := id[l]
+		if !ok {
+			c = len(id)
+			id[l] = c
+		}
+		labels[v] = c
+	}
+	return len(id)
+}
+
+// Count returns the number of communities.
+func (lp *LabelPropagation) Count() int {
+	return lp.count
+}
+
+// Community returns the id of the community containing vertex v.
+func (lp *LabelPropagation) Community(v int) int {
+	lp.validateVertex(v)
+	return lp.community[v]
+}
+
+// Communities returns the vertices of every community, indexed by community id.
+func (lp *LabelPropagation) Communities() (communities [][]int) {
+	communities = make([][]int, lp.count)
+	for v, c := range lp.community {
+		communities[c] = append(communities[c], v)
+	}
+	return communities
+}
+
+// Modularity returns the modularity of the partition into communities.
+func (lp *LabelPropagation) Modularity() float64 {
+	return lp.modularity
+}
+
+// Passes returns the number of passes over the vertices.
+func (lp *LabelPropagation) Passes() int {
+	return lp.passes
+}
+
+func (lp *LabelPropagation) validateVertex(v int) {
+	length := len(lp.community)
+	if v < 0 || v >= length {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", length-1))
+	}
+}
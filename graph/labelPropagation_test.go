@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelPropagation(t *testing.T) {
+	assert := assert.New(t)
+
+	// two triangles joined by the edge 2-3, and the isolated vertex 6
+	g := NewGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(3, 5)
+	g.AddEdge(2, 3)
+
+	split := []int{0, 0, 0, 1, 1, 1, 2}
+	assert.InEpsilon(2*(6.0/14-0.25), Modularity(g, split), 1e-12)
+	assert.Equal(0.0, Modularity(NewGraph(2), []int{0, 1}))
+	assert.Panics(func() { Modularity(g, []int{0}) })
+
+	for seed := int64(0); seed < 10; seed++ {
+		lp := NewLabelPropagation(g, seed)
+		assert.Equal(lp.Community(0), lp.Community(1))
+		assert.Equal(lp.Community(4), lp.Community(5))
+		assert.Equal(lp.Community(6), lp.Count()-1)
+		labels := make([]int, g.V())
+		for v := range labels {
+			labels[v] = lp.Community(v)
+		}
+		assert.Equal(Modularity(g, labels), lp.Modularity())
+	}
+
+	lp := NewLabelPropagation(g, 3)
+	again := NewLabelPropagation(g, 3)
+	assert.Equal(lp.Communities(), again.Communities())
+	assert.Equal(lp.Passes(), again.Passes())
+	assert.Equal(0, lp.Community(0))
+	assert.Panics(func() { lp.Community(7) })
+}
@@ -0,0 +1,119 @@
+package graph
+
+// MaximalCliques enumerates the maximal cliques of the undirected graph G, calling visit with the vertices
+// of each clique in ascending order. A clique is a set of pairwise adjacent vertices; it is maximal if no other
+// vertex is adjacent to all of them. Self-loops and parallel edges are ignored, and every isolated vertex is
+// a maximal clique of its own. The slice passed to visit is only valid during the call. Enumeration stops
+// early if visit returns false.
+// This implementation uses the Bron–Kerbosch algorithm with pivoting, visiting the top-level vertices in a
+// degeneracy ordering (see CoreDecomposition). It takes O(d V 3^(d/3)) time, where V is the number of
+// vertices and d is the degeneracy of G, and O(V + E) extra space.
+func MaximalCliques(G *Graph, visit func(clique []int) bool) {
+	V := G.V()
+	// neighbor sets without self-loops or parallel edges
+	adj := make([]map[int]bool, V)
+	for v := 0; v < V; v++ {
+		adj[v] = make(map[int]bool)
+		for _, w := range G.Adj(v) {
+			if w != v {
+				adj[v][w] = true
+			}
+		}
+	}
+
+	bk := &bronKerbosch{adj: adj, visit: visit}
+	order := NewCoreDecomposition(G).Order()
+	position := make([]int, V)
+	for i, v := range order {
+		position[v] = i
+	}
+	for _, v := range order {
+		// later neighbors are candidates, earlier ones have been handled already
+		var p, x []int
+		for w := range adj[v] {
+			if position[w] > position[v] {
+				p = append(p, w)
+			} else {
+				x = append(x, w)
+			}
+		}
+		if !bk.expand([]int{v}, p, x) {
+			return
+		}
+	}
+}
+
+type bronKerbosch struct {
+	adj   []map[int]bool
+	visit func(clique []int) bool
+}
+
+// reports all maximal cliques that contain r, some vertices of p and no vertex of x;
+// returns false if the enumeration was stopped
+func (bk *bronKerbosch) expand(r, p, x []int) bool {
+	if len(p) == 0 {
+		if len(x) == 0 {
+			clique := make([]int, len(r))
+			copy(clique, r)
+			insertionSort(clique)
+			return bk.visit(clique)
+		}
+		return true
+	}
+
+	// choose the pivot u in p or x with the most neighbors in p
+	pivot, most := -1, -1
+	for _, set := range [][]int{p, x} {
+		for _, u := range set {
+			n := 0
+			for _, w := range p {
+				if bk.adj[u][w] {
+					n++
+				}
+			}
+			if n > most {
+				pivot, most = u, n
+			}
+		}
+	}
+
+	candidates := make([]int, 0, len(p))
+	for _, v := range p {
+		if !bk.adj[pivot][v] {
+			candidates = append(candidates, v)
+		}
+	}
+	for _, v := range candidates {
+		var np, nx []int
+		for _, w := range p {
+			if bk.adj[v][w] {
+				np = append(np, w)
+			}
+		}
+		for _, w := range x {
+			if bk.adj[v][w] {
+				nx = append(nx, w)
+			}
+		}
+		if !bk.expand(append(r, v), np, nx) {
+			return false
+		}
+		// move v from p to x
+		for i, w := range p {
+			if w == v {
+				p = append(p[:i:i], p[i+1:]...)
+				break
+			}
+		}
+		x = append(x[:len(x):len(x)], v)
+	}
+	return true
+}
+
+func insertionSort(a []int) {
+	for i := 1; i < len(a); i++ {
+		for j := i; j > 0 && a[j] < a[j-1]; j-- {
+			a[j], a[j-1] = a[j-1], a[j]
+		}
+	}
+}
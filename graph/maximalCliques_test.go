@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	assert := assert.New(t)
+
+	// the 4-clique 0-1-2-3, the triangle 3-4-5, the edge 5-6, a self-loop on 6,
+	// a parallel edge 0-1 and the isolated vertex 7
+	g := NewGraph(8)
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3},
+		{3, 4}, {4, 5}, {3, 5}, {5, 6}, {6, 6}, {0, 1}} {
+		g.AddEdge(e[0], e[1])
+	}
+	var cliques [][]int
+	MaximalCliques(g, func(clique []int) bool {
+		cliques = append(cliques, append([]int(nil), clique...))
+		return true
+	})
+	sortCliques(cliques)
+	assert.Equal([][]int{{0, 1, 2, 3}, {3, 4, 5}, {5, 6}, {7}}, cliques)
+
+	// stop after the first clique
+	count := 0
+	MaximalCliques(g, func(clique []int) bool {
+		count++
+		return false
+	})
+	assert.Equal(1, count)
+
+	MaximalCliques(NewGraph(0), func(clique []int) bool {
+		assert.Fail("empty graph has no cliques")
+		return true
+	})
+}
+
+func TestMaximalCliquesRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(11))
+	for trial := 0; trial < 20; trial++ {
+		V := 12
+		g := NewGraph(V)
+		adj := make([][]bool, V)
+		for v := range adj {
+			adj[v] = make([]bool, V)
+		}
+		for v := 0; v < V; v++ {
+			for w := v + 1; w < V; w++ {
+				if r.Float64() < 0.5 {
+					g.AddEdge(v, w)
+					adj[v][w], adj[w][v] = true, true
+				}
+			}
+		}
+
+		var cliques [][]int
+		MaximalCliques(g, func(clique []int) bool {
+			cliques = append(cliques, append([]int(nil), clique...))
+			return true
+		})
+		sortCliques(cliques)
+
+		// brute force over all vertex subsets
+		var expected [][]int
+		for mask := 1; mask < 1<<V; mask++ {
+			if isMaximalClique(adj, mask) {
+				var clique []int
+				for v := 0; v < V; v++ {
+					if mask&(1<<v) != 0 {
+						clique = append(clique, v)
+					}
+				}
+				expected = append(expected, clique)
+			}
+		}
+		sortCliques(expected)
+		assert.Equal(expected, cliques)
+	}
+}
+
+func isMaximalClique(adj [][]bool, mask int) bool {
+	V := len(adj)
+	for v := 0; v < V; v++ {
+		all := true
+		for w := 0; w < V; w++ {
+			if w != v && mask&(1<<w) != 0 && !adj[v][w] {
+				all = false
+				break
+			}
+		}
+		if mask&(1<<v) != 0 && !all {
+			return false
+		}
+		if mask&(1<<v) == 0 && all {
+			return false
+		}
+	}
+	return true
+}
+
+func sortCliques(cliques [][]int) {
+	sort.Slice(cliques, func(i, j int) bool {
+		a, b := cliques[i], cliques[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
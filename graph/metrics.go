@@ -0,0 +1,224 @@
+package graph
+
+import "fmt"
+
+// Metrics struct represents a data type for computing the distance-based metrics of an undirected graph:
+// the eccentricity of every vertex, the diameter, radius, center and periphery, and the girth.
+// The eccentricity of v is the largest distance from v to a vertex connected to v, so the metrics of
+// a graph that is not connected describe its connected components: the diameter is the largest diameter of
+// a component, and an isolated vertex has eccentricity 0. The girth is the length of a shortest cycle,
+// where a self-loop is a cycle of length 1 and a pair of parallel edges is a cycle of length 2.
+// This implementation computes the eccentricities with the bounding algorithm of Takes and Kosters, which keeps
+// a lower and an upper bound on every eccentricity and tightens them after each breadth-first search,
+// alternately searching from the vertex with the largest upper bound and the one with the smallest lower bound,
+// until every bound is exact. The girth is computed by a breadth-first search from every vertex, cut off at
+// half the length of the shortest cycle found so far.
+// The constructor takes O(V E) time in the worst case, where V is the number of vertices and E is the number
+// of edges, but usually needs far fewer than V searches. Each instance method takes O(1) time,
+// except Center and Periphery, which take O(V) time. It uses O(V) extra space (not including the graph).
+type Metrics struct {
+	eccentricity []int // eccentricity[v] = eccentricity of v
+	diameter     int
+	radius       int
+	girth        int // length of a shortest cycle, -1 if acyclic
+	searches     int // number of breadth-first searches used for the eccentricities
+}
+
+// NewMetrics computes the distance-based metrics of the graph G.
+func NewMetrics(G *Graph) *Metrics {
+	V := G.V()
+	adj := make([][]int, V)
+	for v := 0; v < V; v++ {
+		adj[v] = G.Adj(v)
+	}
+	m := &Metrics{eccentricity: make([]int, V)}
+	m.eccentricities(adj)
+	for v, e := range m.eccentricity {
+		if e > m.diameter {
+			m.diameter = e
+		}
+		if v == 0 || e < m.radius {
+			m.radius = e
+		}
+	}
+	m.girth = girth(adj)
+	return m
+}
+
+// computes every eccentricity by tightening lower and upper bounds
+func (m *Metrics) eccentricities(adj [][]int) {
+	V := len(adj)
+	lower := make([]int, V)
+	upper := make([]int, V)
+	done := make([]bool, V)
+	dist := make([]int, V)
+	for v := 0; v < V; v++ {
+		upper[v] = infinity
+		dist[v] = -1
+	}
+	var order []int
+	remaining := V
+	for high := true; remaining > 0; high = !high {
+		// pick the undecided vertex of largest upper or smallest lower bound, breaking ties by degree
+		v := -1
+		for w := 0; w < V; w++ {
+			if done[w] {
+				continue
+			}
+			switch {
+			case v < 0:
+				v = w
+			case high && (upper[w] > upper[v] || upper[w] == upper[v] && len(adj[w]) > len(adj[v])):
+				v = w
+			case !high && (lower[w] < lower[v] || lower[w] == lower[v] && len(adj[w]) > len(adj[v])):
+				v = w
+			}
+		}
+
+		order = bfs(adj, v, dist, order)
+		m.searches++
+		e := dist[order[len(order)-1]]
+		m.eccentricity[v] = e
+		done[v] = true
+		remaining--
+		// the searched component gets the bounds max(e - d, d) <= ecc(w) <= e + d, where d = dist(v, w)
+		for _, w := range order {
+			d := dist[w]
+			dist[w] = -1
+			if done[w] {
+				continue
+			}
+			if e-d > lower[w] {
+				lower[w] = e - d
+			}
+			if d > lower[w] {
+				lower[w] = d
+			}
+			if e+d < upper[w] {
+				upper[w] = e + d
+			}
+			if lower[w] == upper[w] {
+				m.eccentricity[w] = lower[w]
+				done[w] = true
+				remaining--
+			}
+		}
+	}
+}
+
+// breadth-first search from s; sets dist of the reached vertices, which must be -1 beforehand,
+// and returns them in order of distance, reusing the order slice
+func bfs(adj [][]int, s int, dist []int, order []int) []int {
+	order = append(order[:0], s)
+	dist[s] = 0
+	// order doubles as the queue
+	for head := 0; head < len(order); head++ {
+		v := order[head]
+		for _, w := range adj[v] {
+			if dist[w] < 0 {
+				dist[w] = dist[v] + 1
+				order = append(order, w)
+			}
+		}
+	}
+	return order
+}
+
+// returns the length of a shortest cycle, -1 if there is none
+func girth(adj [][]int) int {
+	V := len(adj)
+	best := infinity
+	dist := make([]int, V)
+	parent := make([]int, V)
+	for v := range dist {
+		dist[v] = -1
+	}
+	var order []int
+	for s := 0; s < V; s++ {
+		order = append(order[:0], s)
+		dist[s] = 0
+		parent[s] = -1
+		for head := 0; head < len(order); head++ {
+			u := order[head]
+			// no cycle found from here on is shorter than 2 dist[u] + 1
+			if 2*dist[u]+1 >= best {
+				break
+			}
+			skippedParent := false
+			for _, w := range adj[u] {
+				// skip the tree edge to the parent once; another copy of it is a parallel edge
+				if w == parent[u] && !skippedParent {
+					skippedParent = true
+					continue
+				}
+				if dist[w] < 0 {
+					dist[w] = dist[u] + 1
+					parent[w] = u
+					order = append(order, w)
+				} else if length := dist[u] + dist[w] + 1; length < best {
+					best = length
+				}
+			}
+		}
+		for _, v := range order {
+			dist[v] = -1
+		}
+	}
+	if best == infinity {
+		return -1
+	}
+	return best
+}
+
+// Eccentricity returns the eccentricity of vertex v.
+func (m *Metrics) Eccentricity(v int) int {
+	m.validateVertex(v)
+	return m.eccentricity[v]
+}
+
+// Diameter returns the largest eccentricity of any vertex, 0 for a graph without vertices.
+func (m *Metrics) Diameter() int {
+	return m.diameter
+}
+
+// Radius returns the smallest eccentricity of any vertex, 0 for a graph without vertices.
+func (m *Metrics) Radius() int {
+	return m.radius
+}
+
+// Center returns the vertices whose eccentricity equals the radius, in ascending order.
+func (m *Metrics) Center() (vertices []int) {
+	for v, e := range m.eccentricity {
+		if e == m.radius {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// Periphery returns the vertices whose eccentricity equals the diameter, in ascending order.
+func (m *Metrics) Periphery() (vertices []int) {
+	for v, e := range m.eccentricity {
+		if e == m.diameter {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// Girth returns the length of a shortest cycle, or -1 if the graph is acyclic.
+func (m *Metrics) Girth() int {
+	return m.girth
+}
+
+// Searches returns the number of breadth-first searches used to compute the eccentricities.
+func (m *Metrics) Searches() int {
+	return m.searches
+}
+
+func (m *Metrics) validateVertex(v int) {
+	V := len(m.eccentricity)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	// the path 0-1-2-3-4 with the triangle 2-5-6 hanging off its middle, and the edge 7-8
+	g := NewGraph(9)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {2, 5}, {5, 6}, {6, 2}, {7, 8}} {
+		g.AddEdge(e[0], e[1])
+	}
+	m := NewMetrics(g)
+	expected := []int{4, 3, 2, 3, 4, 3, 3, 1, 1}
+	for v, e := range expected {
+		assert.Equal(e, m.Eccentricity(v))
+	}
+	assert.Equal(4, m.Diameter())
+	assert.Equal(1, m.Radius())
+	assert.Equal([]int{7, 8}, m.Center())
+	assert.Equal([]int{0, 4}, m.Periphery())
+	assert.Equal(3, m.Girth())
+	assert.Panics(func() { m.Eccentricity(9) })
+
+	// the Petersen graph
+	p := NewGraph(10)
+	for i := 0; i < 5; i++ {
+		p.AddEdge(i, (i+1)%5)
+		p.AddEdge(i, i+5)
+		p.AddEdge(i+5, (i+2)%5+5)
+	}
+	pm := NewMetrics(p)
+	assert.Equal(2, pm.Diameter())
+	assert.Equal(2, pm.Radius())
+	assert.Equal(5, pm.Girth())
+
+	tree := NewGraph(3)
+	tree.AddEdge(0, 1)
+	tree.AddEdge(1, 2)
+	assert.Equal(-1, NewMetrics(tree).Girth())
+	tree.AddEdge(2, 1)
+	assert.Equal(2, NewMetrics(tree).Girth())
+	tree.AddEdge(0, 0)
+	assert.Equal(1, NewMetrics(tree).Girth())
+
+	empty := NewMetrics(NewGraph(0))
+	assert.Equal(0, empty.Diameter())
+	assert.Equal(0, empty.Radius())
+	assert.Equal(-1, empty.Girth())
+}
+
+func TestMetricsRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(9))
+	for trial := 0; trial < 10; trial++ {
+		V := 200
+		g := NewGraph(V)
+		edges := make([][2]int, 230)
+		for i := range edges {
+			edges[i] = [2]int{r.Intn(V), r.Intn(V)}
+			g.AddEdge(edges[i][0], edges[i][1])
+		}
+		adj := make([][]int, V)
+		for v := range adj {
+			adj[v] = g.Adj(v)
+		}
+		m := NewMetrics(g)
+		dist := make([]int, V)
+		for v := range dist {
+			dist[v] = -1
+		}
+		for v := 0; v < V; v++ {
+			order := bfs(adj, v, dist, nil)
+			assert.Equal(dist[order[len(order)-1]], m.Eccentricity(v))
+			for _, w := range order {
+				dist[w] = -1
+			}
+		}
+		assert.Less(m.Searches(), V)
+		assert.Equal(bruteForceGirth(V, edges), m.Girth())
+	}
+}
+
+// returns the length of a shortest cycle: the shortest path between the ends of an edge avoiding that edge,
+// plus the edge itself
+func bruteForceGirth(V int, edges [][2]int) int {
+	best := -1
+	for i, e := range edges {
+		g := NewGraph(V)
+		for j, f := range edges {
+			if j != i {
+				g.AddEdge(f[0], f[1])
+			}
+		}
+		if bfp := NewBreadthFirstPaths(g, e[0]); bfp.HasPathTo(e[1]) {
+			if length := bfp.DistTo(e[1]) + 1; best < 0 || length < best {
+				best = length
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,167 @@
+package graph
+
+import "fmt"
+
+// The operations below build a new graph from one or two graphs, leaving them unchanged.
+// Parallel edges count with their multiplicity: the edge union of G and H has every edge v-w
+// as many times as the larger of its multiplicities in G and H, and their edge intersection as many
+// times as the smaller. Each operation takes time proportional to the size of its result, plus O(E + V)
+// for the graphs it reads.
+
+// DisjointUnion returns the disjoint union of G and H: the vertices and edges of G, followed by those of H,
+// with vertex v of H numbered G.V() + v.
+func DisjointUnion(G, H *Graph) *Graph {
+	U := NewGraph(G.V() + H.V())
+	for _, e := range G.edges() {
+		U.AddEdge(e[0], e[1])
+	}
+	for _, e := range H.edges() {
+		U.AddEdge(G.V()+e[0], G.V()+e[1])
+	}
+	return U
+}
+
+// EdgeUnion returns the graph on the vertices of G and H with the edges of either.
+// It panics if G and H do not have the same number of vertices.
+func EdgeUnion(G, H *Graph) *Graph {
+	validateSameVertices(G, H)
+	U := NewGraph(G.V())
+	inG := make(map[[2]int]int)
+	for _, e := range G.edges() {
+		inG[e]++
+		U.AddEdge(e[0], e[1])
+	}
+	inH := make(map[[2]int]int)
+	for _, e := range H.edges() {
+		inH[e]++
+		if inH[e] > inG[e] {
+			U.AddEdge(e[0], e[1])
+		}
+	}
+	return U
+}
+
+// EdgeIntersection returns the graph on the vertices of G and H with the edges of both.
+// It panics if G and H do not have the same number of vertices.
+func EdgeIntersection(G, H *Graph) *Graph {
+	validateSameVertices(G, H)
+	I := NewGraph(G.V())
+	inH := make(map[[2]int]int)
+	for _, e := range H.edges() {
+		inH[e]++
+	}
+	for _, e := range G.edges() {
+		if inH[e] > 0 {
+			inH[e]--
+			I.AddEdge(e[0], e[1])
+		}
+	}
+	return I
+}
+
+// Complement returns the complement of G: the simple graph on the vertices of G with an edge v-w
+// for every pair of distinct vertices v and w not adjacent in G.
+func Complement(G *Graph) *Graph {
+	V := G.V()
+	C := NewGraph(V)
+	adjacent := make([]bool, V)
+	for v := 0; v < V; v++ {
+		for _, w := range G.Adj(v) {
+			adjacent[w] = true
+		}
+		for w := v + 1; w < V; w++ {
+			if !adjacent[w] {
+				C.AddEdge(v, w)
+			}
+		}
+		for _, w := range G.Adj(v) {
+			adjacent[w] = false
+		}
+	}
+	return C
+}
+
+// LineGraph returns the line graph of G, with a vertex for every edge of G and an edge between every two edges
+// of G that share an endpoint, and the endpoints of the edge of G for every vertex, the smaller one first.
+func LineGraph(G *Graph) (*Graph, [][2]int) {
+	edges := G.edges()
+	L := NewGraph(len(edges))
+	incident := make([][]int, G.V())
+	for i, e := range edges {
+		incident[e[0]] = append(incident[e[0]], i)
+		if e[1] != e[0] {
+			incident[e[1]] = append(incident[e[1]], i)
+		}
+	}
+	// parallel edges share both endpoints, but are adjacent once
+	added := make(map[[2]int]bool)
+	for v := 0; v < G.V(); v++ {
+		for j, x := range incident[v] {
+			for _, y := range incident[v][:j] {
+				if !added[[2]int{y, x}] {
+					added[[2]int{y, x}] = true
+					L.AddEdge(y, x)
+				}
+			}
+		}
+	}
+	return L, edges
+}
+
+// CartesianProduct returns the Cartesian product of G and H, with vertex g*H.V() + h for every vertex g of G
+// and h of H, and an edge between (g, h) and (g', h') if g = g' and h-h' is an edge of H,
+// or h = h' and g-g' is an edge of G.
+func CartesianProduct(G, H *Graph) *Graph {
+	P := NewGraph(G.V() * H.V())
+	for _, e := range G.edges() {
+		for h := 0; h < H.V(); h++ {
+			P.AddEdge(e[0]*H.V()+h, e[1]*H.V()+h)
+		}
+	}
+	for g := 0; g < G.V(); g++ {
+		for _, e := range H.edges() {
+			P.AddEdge(g*H.V()+e[0], g*H.V()+e[1])
+		}
+	}
+	return P
+}
+
+// TensorProduct returns the tensor product of G and H, with vertex g*H.V() + h for every vertex g of G
+// and h of H, and an edge between (g, h) and (g', h') if g-g' is an edge of G and h-h' is an edge of H.
+func TensorProduct(G, H *Graph) *Graph {
+	P := NewGraph(G.V() * H.V())
+	for _, e := range G.edges() {
+		for _, f := range H.edges() {
+			P.AddEdge(e[0]*H.V()+f[0], e[1]*H.V()+f[1])
+			// the edge pairs the other ends too, unless one is a self loop
+			if e[0] != e[1] && f[0] != f[1] {
+				P.AddEdge(e[0]*H.V()+f[1], e[1]*H.V()+f[0])
+			}
+		}
+	}
+	return P
+}
+
+// returns the edges of this graph once each, the smaller endpoint first, in the order of the adjacency lists
+func (g *Graph) edges() (edges [][2]int) {
+	for v := 0; v < g.v; v++ {
+		selfLoops := 0
+		for _, x := range g.adj[v].Values() {
+			w := x.(int)
+			// a self loop is in the list twice
+			if w == v {
+				selfLoops++
+			}
+			if w > v || w == v && selfLoops%2 == 1 {
+				edges = append(edges, [2]int{v, w})
+			}
+		}
+	}
+	return edges
+}
+
+func validateSameVertices(G, H *Graph) {
+	if G.V() != H.V() {
+		panic(fmt.Sprintln("graphs have ", G.V(), " and ", H.V(), " vertices"))
+	}
+}
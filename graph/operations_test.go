@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the path 0-1-...-(V-1)
+func pathGraph(V int) *Graph {
+	G := NewGraph(V)
+	for v := 0; v+1 < V; v++ {
+		G.AddEdge(v, v+1)
+	}
+	return G
+}
+
+func TestDisjointUnion(t *testing.T) {
+	assert := assert.New(t)
+	U := DisjointUnion(pathGraph(2), pathGraph(3))
+	assert.Equal(5, U.V())
+	assert.Equal(3, U.E())
+	assert.Equal([]int{1}, U.Adj(0))
+	assert.ElementsMatch([]int{2, 4}, U.Adj(3))
+	assert.Equal(2, NewCC(U).Count())
+}
+
+func TestEdgeUnionIntersection(t *testing.T) {
+	assert := assert.New(t)
+	G := NewGraph(3)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 1)
+	G.AddEdge(1, 2)
+	G.AddEdge(2, 2)
+	H := NewGraph(3)
+	H.AddEdge(1, 0)
+	H.AddEdge(0, 2)
+	H.AddEdge(2, 2)
+
+	U := EdgeUnion(G, H)
+	assert.Equal(5, U.E())
+	assert.ElementsMatch([]int{1, 1, 2}, U.Adj(0))
+	assert.ElementsMatch([]int{0, 1, 2, 2}, U.Adj(2))
+
+	I := EdgeIntersection(G, H)
+	assert.Equal(2, I.E())
+	assert.Equal([]int{1}, I.Adj(0))
+	assert.Equal([]int{2, 2}, I.Adj(2))
+
+	assert.Panics(func() { EdgeUnion(G, NewGraph(2)) })
+}
+
+func TestComplement(t *testing.T) {
+	assert := assert.New(t)
+	G := pathGraph(4)
+	G.AddEdge(1, 2)
+	G.AddEdge(3, 3)
+	C := Complement(G)
+	assert.Equal(3, C.E())
+	assert.ElementsMatch([]int{2, 3}, C.Adj(0))
+	assert.Equal([]int{3}, C.Adj(1))
+	assert.Equal(6, Complement(NewGraph(4)).E())
+}
+
+func TestLineGraph(t *testing.T) {
+	assert := assert.New(t)
+	// a star with three leaves and a parallel edge
+	G := NewGraph(4)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 2)
+	G.AddEdge(0, 3)
+	G.AddEdge(0, 3)
+
+	L, edges := LineGraph(G)
+	assert.Equal(4, L.V())
+	assert.Equal(6, L.E())
+	for _, e := range edges {
+		assert.Equal(0, e[0])
+	}
+	assert.Equal(3, L.Degree(0))
+
+	L, edges = LineGraph(pathGraph(4))
+	assert.Equal([][2]int{{0, 1}, {1, 2}, {2, 3}}, edges)
+	assert.Equal(2, L.E())
+	assert.Equal([]int{1}, L.Adj(0))
+}
+
+func TestCartesianProduct(t *testing.T) {
+	assert := assert.New(t)
+	// the grid of a path of 2 and a path of 3
+	P := CartesianProduct(pathGraph(2), pathGraph(3))
+	assert.Equal(6, P.V())
+	assert.Equal(7, P.E())
+	assert.ElementsMatch([]int{0, 2, 4}, P.Adj(1))
+	assert.ElementsMatch([]int{3, 5, 1}, P.Adj(4))
+}
+
+func TestTensorProduct(t *testing.T) {
+	assert := assert.New(t)
+	// the product of two paths of 2 is two disjoint edges
+	P := TensorProduct(pathGraph(2), pathGraph(2))
+	assert.Equal(4, P.V())
+	assert.Equal(2, P.E())
+	assert.Equal([]int{3}, P.Adj(0))
+	assert.Equal([]int{2}, P.Adj(1))
+
+	// a self loop pairs every edge with itself
+	G := NewGraph(1)
+	G.AddEdge(0, 0)
+	P = TensorProduct(G, pathGraph(3))
+	assert.Equal(2, P.E())
+	assert.ElementsMatch([]int{0, 2}, P.Adj(1))
+}
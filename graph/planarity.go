@@ -0,0 +1,608 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Planarity struct represents a data type for determining whether an undirected graph is planar,
+// that is, whether it can be drawn in the plane without crossing edges.
+// If it is, a combinatorial embedding is given as a rotation system: for every vertex, the clockwise order of
+// its neighbors around it in a planar drawing. If it is not, a certificate is given as the edges of a
+// subgraph that is a subdivision of K5 or K3,3 (Kuratowski's theorem). Self-loops and parallel edges do not
+// affect planarity and are ignored.
+// This implementation uses the left-right planarity test of de Fraysseix and Rosenstiehl, in the formulation
+// of Brandes: a depth-first search orients the graph, a second one assigns every back edge to the left or right
+// side of its tree path subject to the constraints between them, and the sides determine the embedding.
+// The test and the embedding take O(V + E) time, where V is the number of vertices and E is the number of edges.
+// The constructor takes O(V + E) time, and IsPlanar O(1). The certificate is not found in linear time: the first
+// call to Kuratowski or BranchVertices finds it by testing each of at most 3V - 5 edges in turn and deleting it
+// if the graph stays nonplanar without it, which takes O(V^2) time, rather than the O(V + E) of the linear-time
+// Kuratowski extraction algorithms. Otherwise Rotation, Faces, Kuratowski and BranchVertices take time
+// proportional to their output. It uses O(V + E) extra space (not including the graph).
+type Planarity struct {
+	isPlanar   bool
+	rotation   [][]int  // rotation[v] = neighbors of v in clockwise order, if planar
+	nonplanar  [][2]int // edges of a nonplanar subgraph, until the Kuratowski subdivision is found in them
+	kuratowski [][2]int // edges of a Kuratowski subdivision, if not planar
+	branch     []int    // branch vertices of the Kuratowski subdivision
+	v          int      // number of vertices
+}
+
+// NewPlanarity determines whether the graph G is planar and finds either an embedding or a Kuratowski subgraph.
+func NewPlanarity(G *Graph) *Planarity {
+	V := G.V()
+	var edges [][2]int
+	for v, neighbors := range distinctNeighbors(G) {
+		for _, w := range neighbors {
+			if v < w {
+				edges = append(edges, [2]int{v, w})
+			}
+		}
+	}
+
+	p := &Planarity{v: V}
+	// a simple planar graph with V >= 3 vertices has at most 3V - 6 edges
+	if V >= 3 && len(edges) > 3*V-6 {
+		p.nonplanar = edges[:3*V-5]
+		return p
+	}
+	lr := newLeftRight(V, edges)
+	if p.isPlanar = lr.test(); p.isPlanar {
+		p.rotation = lr.embedding()
+	} else {
+		p.nonplanar = edges
+	}
+	return p
+}
+
+// reduces the nonplanar edge set to a minimal nonplanar subgraph, which is a Kuratowski subdivision,
+// unless it has been found already
+func (p *Planarity) findKuratowski() {
+	if p.nonplanar == nil {
+		return
+	}
+	V := p.v
+	kept := p.nonplanar
+	p.nonplanar = nil
+	for i := 0; i < len(kept); {
+		without := make([][2]int, 0, len(kept)-1)
+		without = append(without, kept[:i]...)
+		without = append(without, kept[i+1:]...)
+		if newLeftRight(V, without).test() {
+			// the edge is needed
+			i++
+		} else {
+			kept = without
+		}
+	}
+	p.kuratowski = kept
+
+	degree := make([]int, V)
+	for _, e := range kept {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	for v, d := range degree {
+		if d > 2 {
+			p.branch = append(p.branch, v)
+		}
+	}
+}
+
+// IsPlanar returns true if the graph is planar.
+func (p *Planarity) IsPlanar() bool {
+	return p.isPlanar
+}
+
+// Rotation returns the distinct neighbors of vertex v in clockwise order around v in a planar embedding.
+func (p *Planarity) Rotation(v int) (neighbors []int) {
+	if !p.isPlanar {
+		panic("graph is not planar")
+	}
+	p.validateVertex(v)
+	neighbors = make([]int, len(p.rotation[v]))
+	copy(neighbors, p.rotation[v])
+	return neighbors
+}
+
+// Faces returns the faces of the planar embedding, each as the cyclic sequence of vertices along its boundary.
+// Every connected component with at least one edge contributes its own outer face.
+func (p *Planarity) Faces() (faces [][]int) {
+	if !p.isPlanar {
+		panic("graph is not planar")
+	}
+	// position[v][w] = index of w in the rotation of v
+	position := make([]map[int]int, len(p.rotation))
+	for v, neighbors := range p.rotation {
+		position[v] = make(map[int]int, len(neighbors))
+		for i, w := range neighbors {
+			position[v][w] = i
+		}
+	}
+	visited := make([]map[int]bool, len(p.rotation))
+	for v := range visited {
+		visited[v] = make(map[int]bool)
+	}
+	for v, neighbors := range p.rotation {
+		for _, w := range neighbors {
+			if visited[v][w] {
+				continue
+			}
+			// follow the half-edges u->x, continuing from x to the neighbor after u in the rotation of x
+			var face []int
+			for u, x := v, w; !visited[u][x]; {
+				visited[u][x] = true
+				face = append(face, u)
+				next := p.rotation[x][(position[x][u]+1)%len(p.rotation[x])]
+				u, x = x, next
+			}
+			faces = append(faces, face)
+		}
+	}
+	return faces
+}
+
+// Kuratowski returns the edges of a subgraph that is a subdivision of K5 or K3,3, each as a pair of vertices
+// in ascending order, or nil if the graph is planar.
+func (p *Planarity) Kuratowski() (edges [][2]int) {
+	if p.isPlanar {
+		return nil
+	}
+	p.findKuratowski()
+	edges = make([][2]int, len(p.kuratowski))
+	copy(edges, p.kuratowski)
+	return edges
+}
+
+// BranchVertices returns the vertices of degree more than two in the Kuratowski subgraph, in ascending order:
+// five vertices for a subdivision of K5 and six for a subdivision of K3,3. It returns nil if the graph is planar.
+func (p *Planarity) BranchVertices() (vertices []int) {
+	if p.isPlanar {
+		return nil
+	}
+	p.findKuratowski()
+	vertices = make([]int, len(p.branch))
+	copy(vertices, p.branch)
+	return vertices
+}
+
+func (p *Planarity) validateVertex(v int) {
+	V := len(p.rotation)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
+
+// an interval of back edges, from low to high; none is -1
+type lrInterval struct {
+	low, high int
+}
+
+func (i lrInterval) empty() bool {
+	return i.low < 0 && i.high < 0
+}
+
+// a pair of intervals of back edges whose sides conflict
+type lrConflictPair struct {
+	left, right lrInterval
+}
+
+func (c *lrConflictPair) swap() {
+	c.left, c.right = c.right, c.left
+}
+
+// leftRight holds the state of the left-right planarity test on a simple graph.
+// Edges are identified by their index; after the orientation, edge e goes from src[e] to dst[e].
+type leftRight struct {
+	adj         [][]int // adj[v] = edges incident on v
+	src, dst    []int
+	oriented    []bool
+	height      []int // height[v] = depth of v in the DFS tree, -1 if unvisited
+	parentEdge  []int // parentEdge[v] = tree edge to v, -1 for a root
+	lowpt       []int // lowpt[e] = lowest height reached by a back edge from the subtree of e
+	lowpt2      []int // lowpt2[e] = second lowest such height
+	nesting     []int // nesting[e] = nesting depth of e
+	orderedAdj  [][]int
+	roots       []int
+	ref         []int
+	side        []int
+	stack       []*lrConflictPair
+	stackBottom []*lrConflictPair
+	lowptEdge   []int
+	leftRef     []int
+	rightRef    []int
+}
+
+func newLeftRight(V int, edges [][2]int) *leftRight {
+	E := len(edges)
+	lr := &leftRight{
+		adj:         make([][]int, V),
+		src:         make([]int, E),
+		dst:         make([]int, E),
+		oriented:    make([]bool, E),
+		height:      make([]int, V),
+		parentEdge:  make([]int, V),
+		lowpt:       make([]int, E),
+		lowpt2:      make([]int, E),
+		nesting:     make([]int, E),
+		orderedAdj:  make([][]int, V),
+		ref:         make([]int, E),
+		side:        make([]int, E),
+		stackBottom: make([]*lrConflictPair, E),
+		lowptEdge:   make([]int, E),
+		leftRef:     make([]int, V),
+		rightRef:    make([]int, V),
+	}
+	for e, vw := range edges {
+		lr.src[e], lr.dst[e] = vw[0], vw[1]
+		lr.adj[vw[0]] = append(lr.adj[vw[0]], e)
+		lr.adj[vw[1]] = append(lr.adj[vw[1]], e)
+		lr.ref[e] = -1
+		lr.side[e] = 1
+		lr.lowptEdge[e] = -1
+	}
+	for v := 0; v < V; v++ {
+		lr.height[v] = -1
+		lr.parentEdge[v] = -1
+	}
+	return lr
+}
+
+// orients the edges and sorts them by nesting depth, then checks the left-right constraints
+func (lr *leftRight) test() bool {
+	for v := range lr.height {
+		if lr.height[v] < 0 {
+			lr.height[v] = 0
+			lr.roots = append(lr.roots, v)
+			lr.orient(v)
+		}
+	}
+	for v := range lr.orderedAdj {
+		lr.sortByNesting(v)
+	}
+	for _, v := range lr.roots {
+		if !lr.constrain(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (lr *leftRight) sortByNesting(v int) {
+	sort.SliceStable(lr.orderedAdj[v], func(i, j int) bool {
+		return lr.nesting[lr.orderedAdj[v][i]] < lr.nesting[lr.orderedAdj[v][j]]
+	})
+}
+
+// depth-first search from v that orients every edge away from the root and computes lowpoints and nesting depths
+func (lr *leftRight) orient(v int) {
+	e := lr.parentEdge[v]
+	for _, vw := range lr.adj[v] {
+		if lr.oriented[vw] {
+			continue
+		}
+		lr.oriented[vw] = true
+		if lr.src[vw] != v {
+			lr.src[vw], lr.dst[vw] = lr.dst[vw], lr.src[vw]
+		}
+		lr.orderedAdj[v] = append(lr.orderedAdj[v], vw)
+		w := lr.dst[vw]
+		lr.lowpt[vw] = lr.height[v]
+		lr.lowpt2[vw] = lr.height[v]
+		if lr.height[w] < 0 {
+			// tree edge
+			lr.parentEdge[w] = vw
+			lr.height[w] = lr.height[v] + 1
+			lr.orient(w)
+		} else {
+			// back edge
+			lr.lowpt[vw] = lr.height[w]
+		}
+
+		lr.nesting[vw] = 2 * lr.lowpt[vw]
+		if lr.lowpt2[vw] < lr.height[v] {
+			// chordal
+			lr.nesting[vw]++
+		}
+		if e >= 0 {
+			switch {
+			case lr.lowpt[vw] < lr.lowpt[e]:
+				lr.lowpt2[e] = min(lr.lowpt[e], lr.lowpt2[vw])
+				lr.lowpt[e] = lr.lowpt[vw]
+			case lr.lowpt[vw] > lr.lowpt[e]:
+				lr.lowpt2[e] = min(lr.lowpt2[e], lr.lowpt[vw])
+			default:
+				lr.lowpt2[e] = min(lr.lowpt2[e], lr.lowpt2[vw])
+			}
+		}
+	}
+}
+
+func (lr *leftRight) top() *lrConflictPair {
+	if len(lr.stack) == 0 {
+		return nil
+	}
+	return lr.stack[len(lr.stack)-1]
+}
+
+func (lr *leftRight) pop() *lrConflictPair {
+	c := lr.top()
+	lr.stack = lr.stack[:len(lr.stack)-1]
+	return c
+}
+
+// does the interval contain a return edge higher than the lowpoint of b?
+func (lr *leftRight) conflicting(i lrInterval, b int) bool {
+	return !i.empty() && lr.lowpt[i.high] > lr.lowpt[b]
+}
+
+// returns the lowest lowpoint of a return edge in the conflict pair
+func (lr *leftRight) lowest(c *lrConflictPair) int {
+	if c.left.empty() {
+		return lr.lowpt[c.right.low]
+	}
+	if c.right.empty() {
+		return lr.lowpt[c.left.low]
+	}
+	return min(lr.lowpt[c.left.low], lr.lowpt[c.right.low])
+}
+
+// depth-first search from v that collects the constraints on the sides of the back edges;
+// returns false if they cannot be satisfied
+func (lr *leftRight) constrain(v int) bool {
+	e := lr.parentEdge[v]
+	for i, vw := range lr.orderedAdj[v] {
+		w := lr.dst[vw]
+		lr.stackBottom[vw] = lr.top()
+		if vw == lr.parentEdge[w] {
+			// tree edge
+			if !lr.constrain(w) {
+				return false
+			}
+		} else {
+			// back edge
+			lr.lowptEdge[vw] = vw
+			lr.stack = append(lr.stack, &lrConflictPair{left: lrInterval{-1, -1}, right: lrInterval{vw, vw}})
+		}
+
+		// integrate the new return edges
+		if lr.lowpt[vw] < lr.height[v] {
+			if i == 0 {
+				lr.lowptEdge[e] = lr.lowptEdge[vw]
+			} else if !lr.addConstraints(vw, e) {
+				return false
+			}
+		}
+	}
+
+	if e >= 0 {
+		u := lr.src[e]
+		lr.removeBackEdges(u)
+		// the side of e is the side of a highest return edge
+		if lr.lowpt[e] < lr.height[u] {
+			hl := lr.top().left.high
+			hr := lr.top().right.high
+			if hl >= 0 && (hr < 0 || lr.lowpt[hl] > lr.lowpt[hr]) {
+				lr.ref[e] = hl
+			} else {
+				lr.ref[e] = hr
+			}
+		}
+	}
+	return true
+}
+
+func (lr *leftRight) addConstraints(ei, e int) bool {
+	p := &lrConflictPair{left: lrInterval{-1, -1}, right: lrInterval{-1, -1}}
+	// merge the return edges of ei into p.right
+	for {
+		q := lr.pop()
+		if !q.left.empty() {
+			q.swap()
+		}
+		if !q.left.empty() {
+			return false
+		}
+		if lr.lowpt[q.right.low] > lr.lowpt[e] {
+			// merge intervals
+			if p.right.empty() {
+				p.right = q.right
+			} else {
+				lr.ref[p.right.low] = q.right.high
+			}
+			p.right.low = q.right.low
+		} else {
+			// align
+			lr.ref[q.right.low] = lr.lowptEdge[e]
+		}
+		if lr.top() == lr.stackBottom[ei] {
+			break
+		}
+	}
+
+	// merge the conflicting return edges of the earlier edges out of the same vertex into p.left
+	for lr.top() != nil && (lr.conflicting(lr.top().left, ei) || lr.conflicting(lr.top().right, ei)) {
+		q := lr.pop()
+		if lr.conflicting(q.right, ei) {
+			q.swap()
+		}
+		if lr.conflicting(q.right, ei) {
+			return false
+		}
+		// merge the interval below lowpt(ei) into p.right
+		if p.right.low >= 0 {
+			lr.ref[p.right.low] = q.right.high
+		}
+		if q.right.low >= 0 {
+			p.right.low = q.right.low
+		}
+		if p.left.empty() {
+			p.left = q.left
+		} else {
+			lr.ref[p.left.low] = q.left.high
+		}
+		p.left.low = q.left.low
+	}
+
+	if !p.left.empty() || !p.right.empty() {
+		lr.stack = append(lr.stack, p)
+	}
+	return true
+}
+
+// removes the back edges ending at u from the conflict pairs
+func (lr *leftRight) removeBackEdges(u int) {
+	// drop entire conflict pairs
+	for lr.top() != nil && lr.lowest(lr.top()) == lr.height[u] {
+		c := lr.pop()
+		if c.left.low >= 0 {
+			lr.side[c.left.low] = -1
+		}
+	}
+	if lr.top() == nil {
+		return
+	}
+
+	// one more conflict pair to consider
+	c := lr.pop()
+	// trim the left interval
+	for c.left.high >= 0 && lr.dst[c.left.high] == u {
+		c.left.high = lr.ref[c.left.high]
+	}
+	if c.left.high < 0 && c.left.low >= 0 {
+		// just emptied
+		lr.ref[c.left.low] = c.right.low
+		lr.side[c.left.low] = -1
+		c.left.low = -1
+	}
+	// trim the right interval
+	for c.right.high >= 0 && lr.dst[c.right.high] == u {
+		c.right.high = lr.ref[c.right.high]
+	}
+	if c.right.high < 0 && c.right.low >= 0 {
+		// just emptied
+		lr.ref[c.right.low] = c.left.low
+		lr.side[c.right.low] = -1
+		c.right.low = -1
+	}
+	lr.stack = append(lr.stack, c)
+}
+
+// resolves the side of e relative to the edges it refers to
+func (lr *leftRight) sign(e int) int {
+	if lr.ref[e] >= 0 {
+		lr.side[e] *= lr.sign(lr.ref[e])
+		lr.ref[e] = -1
+	}
+	return lr.side[e]
+}
+
+// returns the rotation system of a planar graph after a successful test
+func (lr *leftRight) embedding() [][]int {
+	V := len(lr.adj)
+	for e := range lr.nesting {
+		lr.nesting[e] *= lr.sign(e)
+	}
+	r := newRotation(V)
+	for v := 0; v < V; v++ {
+		lr.sortByNesting(v)
+		previous := -1
+		for _, vw := range lr.orderedAdj[v] {
+			r.addAfter(v, lr.dst[vw], previous)
+			previous = lr.dst[vw]
+		}
+	}
+	for _, v := range lr.roots {
+		lr.embed(v, r)
+	}
+
+	rotation := make([][]int, V)
+	for v := 0; v < V; v++ {
+		if r.first[v] < 0 {
+			continue
+		}
+		w := r.first[v]
+		for {
+			rotation[v] = append(rotation[v], w)
+			if w = r.cw[v][w]; w == r.first[v] {
+				break
+			}
+		}
+	}
+	return rotation
+}
+
+// adds the incoming tree edges and back edges of the subtree of v to the rotations
+func (lr *leftRight) embed(v int, r *rotation) {
+	for _, vw := range lr.orderedAdj[v] {
+		w := lr.dst[vw]
+		if vw == lr.parentEdge[w] {
+			// tree edge
+			r.addFirst(w, v)
+			lr.leftRef[v] = w
+			lr.rightRef[v] = w
+			lr.embed(w, r)
+		} else if lr.side[vw] == 1 {
+			// back edge on the right
+			r.addAfter(w, v, lr.rightRef[w])
+		} else {
+			// back edge on the left
+			r.addBefore(w, v, lr.leftRef[w])
+			lr.leftRef[w] = v
+		}
+	}
+}
+
+// rotation is a rotation system under construction: circular doubly-linked lists of neighbors.
+type rotation struct {
+	cw, ccw []map[int]int // cw[v][w] = neighbor after w clockwise around v
+	first   []int         // first[v] = first neighbor of v, -1 if none
+}
+
+func newRotation(V int) *rotation {
+	r := &rotation{cw: make([]map[int]int, V), ccw: make([]map[int]int, V), first: make([]int, V)}
+	for v := 0; v < V; v++ {
+		r.cw[v] = make(map[int]int)
+		r.ccw[v] = make(map[int]int)
+		r.first[v] = -1
+	}
+	return r
+}
+
+// inserts w clockwise right after ref around v; ref -1 starts the list of v
+func (r *rotation) addAfter(v, w, ref int) {
+	if ref < 0 {
+		r.cw[v][w], r.ccw[v][w] = w, w
+		r.first[v] = w
+		return
+	}
+	next := r.cw[v][ref]
+	r.cw[v][ref], r.ccw[v][w] = w, ref
+	r.cw[v][w], r.ccw[v][next] = next, w
+}
+
+// inserts w counterclockwise right before ref around v
+func (r *rotation) addBefore(v, w, ref int) {
+	r.addAfter(v, w, r.ccw[v][ref])
+	if r.first[v] == ref {
+		r.first[v] = w
+	}
+}
+
+// inserts w as the first neighbor of v
+func (r *rotation) addFirst(v, w int) {
+	if r.first[v] < 0 {
+		r.addAfter(v, w, -1)
+		return
+	}
+	r.addBefore(v, w, r.first[v])
+	r.first[v] = w
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,192 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanarity(t *testing.T) {
+	assert := assert.New(t)
+
+	// a wheel with 6 spokes, a parallel edge, a self-loop, a separate triangle and an isolated vertex
+	g := NewGraph(11)
+	for i := 1; i <= 6; i++ {
+		g.AddEdge(0, i)
+		g.AddEdge(i, i%6+1)
+	}
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 3)
+	g.AddEdge(7, 8)
+	g.AddEdge(8, 9)
+	g.AddEdge(9, 7)
+	p := NewPlanarity(g)
+	assert.True(p.IsPlanar())
+	assert.Nil(p.Kuratowski())
+	assert.Nil(p.BranchVertices())
+	assert.Equal(6, len(p.Rotation(0)))
+	assert.Equal(0, len(p.Rotation(10)))
+	assertEmbedding(assert, g, p)
+	assert.Panics(func() { p.Rotation(11) })
+
+	k5 := NewGraph(5)
+	for v := 0; v < 5; v++ {
+		for w := v + 1; w < 5; w++ {
+			k5.AddEdge(v, w)
+		}
+	}
+	p = NewPlanarity(k5)
+	assert.False(p.IsPlanar())
+	assert.Equal([]int{0, 1, 2, 3, 4}, p.BranchVertices())
+	assert.Equal(10, len(p.Kuratowski()))
+	assertKuratowski(assert, k5, p)
+	assert.Panics(func() { p.Rotation(0) })
+	assert.Panics(func() { p.Faces() })
+
+	// the Petersen graph contains a subdivision of K3,3 but not of K5
+	petersen := NewGraph(10)
+	for i := 0; i < 5; i++ {
+		petersen.AddEdge(i, (i+1)%5)
+		petersen.AddEdge(i, i+5)
+		petersen.AddEdge(i+5, (i+2)%5+5)
+	}
+	p = NewPlanarity(petersen)
+	assert.False(p.IsPlanar())
+	// the certificate is found on demand, once
+	assert.Nil(p.kuratowski)
+	assert.Equal(6, len(p.BranchVertices()))
+	assert.Nil(p.nonplanar)
+	assertKuratowski(assert, petersen, p)
+
+	// removing a vertex from K5 leaves K4, which is planar
+	k4 := NewGraph(5)
+	for v := 1; v < 5; v++ {
+		for w := v + 1; w < 5; w++ {
+			k4.AddEdge(v, w)
+		}
+	}
+	p = NewPlanarity(k4)
+	assert.True(p.IsPlanar())
+	assertEmbedding(assert, k4, p)
+
+	assert.True(NewPlanarity(NewGraph(0)).IsPlanar())
+}
+
+func TestPlanarityRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	planar := 0
+	for trial := 0; trial < 300; trial++ {
+		V := 5 + r.Intn(20)
+		g := NewGraph(V)
+		E := V + r.Intn(2*V)
+		for i := 0; i < E; i++ {
+			g.AddEdge(r.Intn(V), r.Intn(V))
+		}
+		p := NewPlanarity(g)
+		if p.IsPlanar() {
+			planar++
+			assertEmbedding(assert, g, p)
+		} else {
+			assertKuratowski(assert, g, p)
+		}
+	}
+	// both outcomes are exercised
+	assert.Greater(planar, 30)
+	assert.Less(planar, 270)
+
+	// grids are planar
+	n := 12
+	grid := NewGraph(n * n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i+1 < n {
+				grid.AddEdge(i*n+j, (i+1)*n+j)
+			}
+			if j+1 < n {
+				grid.AddEdge(i*n+j, i*n+j+1)
+			}
+		}
+	}
+	p := NewPlanarity(grid)
+	assert.True(p.IsPlanar())
+	assertEmbedding(assert, grid, p)
+}
+
+// checks that the rotation system has the neighbors of every vertex and satisfies Euler's formula
+// V - E + F = 2 on every connected component with an edge
+func assertEmbedding(assert *assert.Assertions, G *Graph, p *Planarity) {
+	adj := distinctNeighbors(G)
+	cc := NewCC(G)
+	vertices := make([]int, cc.Count())
+	edges := make([]int, cc.Count())
+	faces := make([]int, cc.Count())
+	for v := 0; v < G.V(); v++ {
+		assert.ElementsMatch(adj[v], p.Rotation(v))
+		vertices[cc.Id(v)]++
+		edges[cc.Id(v)] += len(adj[v])
+	}
+	for _, face := range p.Faces() {
+		faces[cc.Id(face[0])]++
+	}
+	for c := range vertices {
+		if edges[c] > 0 {
+			assert.Equal(2, vertices[c]-edges[c]/2+faces[c])
+		}
+	}
+}
+
+// checks that the certificate is a subgraph of G that is a subdivision of K5 or K3,3
+func assertKuratowski(assert *assert.Assertions, G *Graph, p *Planarity) {
+	adj := distinctNeighbors(G)
+	sub := make(map[int][]int)
+	for _, e := range p.Kuratowski() {
+		assert.Contains(adj[e[0]], e[1])
+		sub[e[0]] = append(sub[e[0]], e[1])
+		sub[e[1]] = append(sub[e[1]], e[0])
+	}
+	branch := make(map[int]bool)
+	for _, v := range p.BranchVertices() {
+		branch[v] = true
+	}
+	for v, neighbors := range sub {
+		if !branch[v] {
+			assert.Equal(2, len(neighbors))
+		}
+	}
+
+	// follow the paths between branch vertices
+	connects := make(map[[2]int]int)
+	for v := range branch {
+		for _, w := range sub[v] {
+			prev, x := v, w
+			for !branch[x] {
+				next := sub[x][0]
+				if next == prev {
+					next = sub[x][1]
+				}
+				prev, x = x, next
+			}
+			connects[[2]int{v, x}]++
+		}
+	}
+	for pair, n := range connects {
+		assert.Equal(1, n)
+		assert.NotEqual(pair[0], pair[1])
+	}
+	switch len(branch) {
+	case 5:
+		assert.Equal(20, len(connects))
+	case 6:
+		assert.Equal(18, len(connects))
+		// K3,3 is bipartite: no branch vertex is joined to two branch vertices that are joined to each other
+		for pair := range connects {
+			for x := range branch {
+				assert.False(connects[[2]int{pair[0], x}] > 0 && connects[[2]int{pair[1], x}] > 0)
+			}
+		}
+	default:
+		assert.Fail("wrong number of branch vertices", len(branch))
+	}
+}
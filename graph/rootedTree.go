@@ -0,0 +1,208 @@
+package graph
+
+import "fmt"
+
+// RootedTree struct represents a data type for answering queries on a tree rooted at a given vertex: the parent,
+// depth and subtree size of every vertex, lowest common ancestors, k-th ancestors, and the lengths and vertices
+// of the paths between two vertices. Trees such as those from GraphGenerator's Tree and BinaryTree can be queried
+// this way; the constructor panics if the graph is not a tree.
+// This implementation uses an Euler tour of the tree, which lists a vertex every time the depth-first search
+// enters or returns to it, and a sparse table over the tour: the lowest common ancestor of v and w is the
+// shallowest vertex of the tour between their first occurrences, the minimum of two overlapping ranges of the
+// table. It also uses binary lifting, a table of the 2^j-th ancestor of every vertex, for k-th ancestors.
+// The constructor takes O(V log V) time, where V is the number of vertices. LCA, Distance and IsAncestor take
+// O(1) time, Ancestor takes O(log V) time, Path takes time proportional to its length, and the other instance
+// methods take O(1) time. It uses O(V log V) extra space (not including the graph).
+type RootedTree struct {
+	root   int
+	parent []int   // parent[v] = parent of v, -1 for the root
+	depth  []int   // depth[v] = number of edges on the path from the root to v
+	size   []int   // size[v] = number of vertices in the subtree rooted at v
+	first  []int   // first[v] = position of the first occurrence of v in the Euler tour
+	tour   []int   // Euler tour of 2V - 1 vertices
+	sparse [][]int // sparse[j][i] = shallowest vertex among tour[i .. i + 2^j - 1]
+	up     [][]int // up[j][v] = 2^j-th ancestor of v, -1 if there is none
+	log    []int   // log[n] = floor of the binary logarithm of n
+}
+
+// NewRootedTree computes the rooted-tree view of the tree G with the given root.
+func NewRootedTree(G *Graph, root int) *RootedTree {
+	V := G.V()
+	G.validateVertex(root)
+	if G.E() != V-1 {
+		panic("graph is not a tree")
+	}
+	t := &RootedTree{
+		root:   root,
+		parent: make([]int, V),
+		depth:  make([]int, V),
+		size:   make([]int, V),
+		first:  make([]int, V),
+	}
+	for v := range t.parent {
+		t.parent[v] = -1
+		t.first[v] = -1
+	}
+
+	// nonrecursive depth-first search that records the Euler tour
+	adj := make([][]int, V)
+	for v := 0; v < V; v++ {
+		adj[v] = G.Adj(v)
+	}
+	next := make([]int, V) // next[v] = index in adj[v] of the next neighbor to visit
+	t.first[root] = 0
+	t.tour = append(t.tour, root)
+	stack := []int{root}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] == len(adj[v]) {
+			stack = stack[:len(stack)-1]
+			t.size[v]++
+			if p := t.parent[v]; p >= 0 {
+				t.size[p] += t.size[v]
+				t.tour = append(t.tour, p)
+			}
+			continue
+		}
+		w := adj[v][next[v]]
+		next[v]++
+		if t.first[w] >= 0 {
+			continue
+		}
+		t.parent[w] = v
+		t.depth[w] = t.depth[v] + 1
+		t.first[w] = len(t.tour)
+		t.tour = append(t.tour, w)
+		stack = append(stack, w)
+	}
+	// V - 1 edges reaching every vertex form a spanning tree, so there is no cycle
+	if len(t.tour) != 2*V-1 {
+		panic("graph is not a tree")
+	}
+
+	n := len(t.tour)
+	t.log = make([]int, n+1)
+	for i := 2; i <= n; i++ {
+		t.log[i] = t.log[i/2] + 1
+	}
+	t.sparse = [][]int{t.tour}
+	for j := 1; 1<<j <= n; j++ {
+		prev := t.sparse[j-1]
+		row := make([]int, n-1<<j+1)
+		for i := range row {
+			row[i] = t.shallower(prev[i], prev[i+1<<(j-1)])
+		}
+		t.sparse = append(t.sparse, row)
+	}
+	t.up = [][]int{t.parent}
+	for j := 1; 1<<j < V; j++ {
+		prev := t.up[j-1]
+		row := make([]int, V)
+		for v := range row {
+			row[v] = -1
+			if prev[v] >= 0 {
+				row[v] = prev[prev[v]]
+			}
+		}
+		t.up = append(t.up, row)
+	}
+	return t
+}
+
+func (t *RootedTree) shallower(v, w int) int {
+	if t.depth[v] <= t.depth[w] {
+		return v
+	}
+	return w
+}
+
+// Root returns the root of the tree.
+func (t *RootedTree) Root() int {
+	return t.root
+}
+
+// Parent returns the parent of vertex v, -1 if v is the root.
+func (t *RootedTree) Parent(v int) int {
+	t.validateVertex(v)
+	return t.parent[v]
+}
+
+// Depth returns the number of edges on the path from the root to vertex v.
+func (t *RootedTree) Depth(v int) int {
+	t.validateVertex(v)
+	return t.depth[v]
+}
+
+// SubtreeSize returns the number of vertices in the subtree rooted at vertex v, including v.
+func (t *RootedTree) SubtreeSize(v int) int {
+	t.validateVertex(v)
+	return t.size[v]
+}
+
+// IsAncestor returns true if vertex u is an ancestor of vertex v; every vertex is an ancestor of itself.
+func (t *RootedTree) IsAncestor(u, v int) bool {
+	t.validateVertex(u)
+	t.validateVertex(v)
+	// the subtree of u occupies 2 size[u] - 1 consecutive positions of the Euler tour
+	return t.first[u] <= t.first[v] && t.first[v] < t.first[u]+2*t.size[u]-1
+}
+
+// LCA returns the lowest common ancestor of vertices v and w.
+func (t *RootedTree) LCA(v, w int) int {
+	t.validateVertex(v)
+	t.validateVertex(w)
+	lo, hi := t.first[v], t.first[w]
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	j := t.log[hi-lo+1]
+	return t.shallower(t.sparse[j][lo], t.sparse[j][hi-1<<j+1])
+}
+
+// Ancestor returns the k-th ancestor of vertex v (v itself if k is 0, its parent if k is 1),
+// or -1 if v has depth less than k.
+func (t *RootedTree) Ancestor(v, k int) int {
+	t.validateVertex(v)
+	if k < 0 {
+		panic("k must be non negative")
+	}
+	if k > t.depth[v] {
+		return -1
+	}
+	for j := 0; k > 0; j++ {
+		if k&1 == 1 {
+			v = t.up[j][v]
+		}
+		k >>= 1
+	}
+	return v
+}
+
+// Distance returns the number of edges on the path between vertices v and w.
+func (t *RootedTree) Distance(v, w int) int {
+	return t.depth[v] + t.depth[w] - 2*t.depth[t.LCA(v, w)]
+}
+
+// Path returns the vertices on the path from vertex v to vertex w, including both.
+func (t *RootedTree) Path(v, w int) (path []int) {
+	lca := t.LCA(v, w)
+	for ; v != lca; v = t.parent[v] {
+		path = append(path, v)
+	}
+	path = append(path, lca)
+	n := len(path)
+	for ; w != lca; w = t.parent[w] {
+		path = append(path, w)
+	}
+	for i, j := n, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func (t *RootedTree) validateVertex(v int) {
+	V := len(t.parent)
+	if v < 0 || v >= V {
+		panic(fmt.Sprintln("vertex ", v, " is not between 0 and ", V-1))
+	}
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the complete binary tree in which vertex i has parent (i-1)/2
+func heapTree(V int) *Graph {
+	G := NewGraph(V)
+	for v := 1; v < V; v++ {
+		G.AddEdge(v, (v-1)/2)
+	}
+	return G
+}
+
+func TestRootedTree(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := NewRootedTree(heapTree(15), 0)
+	assert.Equal(0, tree.Root())
+	assert.Equal(-1, tree.Parent(0))
+	assert.Equal(6, tree.Parent(13))
+	assert.Equal(3, tree.Depth(14))
+	assert.Equal(15, tree.SubtreeSize(0))
+	assert.Equal(3, tree.SubtreeSize(5))
+	assert.Equal(1, tree.LCA(7, 10))
+	assert.Equal(0, tree.LCA(7, 14))
+	assert.Equal(3, tree.LCA(3, 8))
+	assert.Equal(2, tree.Ancestor(13, 2))
+	assert.Equal(13, tree.Ancestor(13, 0))
+	assert.Equal(-1, tree.Ancestor(13, 4))
+	assert.Equal(6, tree.Distance(7, 14))
+	assert.Equal([]int{8, 3, 1, 4, 10}, tree.Path(8, 10))
+	assert.Equal([]int{5}, tree.Path(5, 5))
+	assert.True(tree.IsAncestor(1, 9))
+	assert.False(tree.IsAncestor(2, 9))
+
+	// the same tree rooted at a leaf
+	tree = NewRootedTree(heapTree(15), 7)
+	assert.Equal(3, tree.Parent(1))
+	assert.Equal(1, tree.SubtreeSize(14))
+	assert.Equal(12, tree.SubtreeSize(1))
+	assert.Equal(1, tree.LCA(10, 14))
+
+	shuffled := NewRootedTree(NewGraphGenerator().Tree(31), 0)
+	assert.Equal(31, shuffled.SubtreeSize(0))
+	single := NewRootedTree(NewGraph(1), 0)
+	assert.Equal(0, single.LCA(0, 0))
+	assert.Equal(1, single.SubtreeSize(0))
+
+	assert.Panics(func() { NewRootedTree(NewGraphGenerator().CycleGraph(5), 0) })
+	G := NewGraph(3)
+	G.AddEdge(0, 1)
+	G.AddEdge(0, 1)
+	assert.Panics(func() { NewRootedTree(G, 0) })
+	G = NewGraph(3)
+	G.AddEdge(0, 1)
+	G.AddEdge(2, 2)
+	assert.Panics(func() { NewRootedTree(G, 0) })
+	assert.Panics(func() { tree.LCA(0, 15) })
+	assert.Panics(func() { tree.Ancestor(0, -1) })
+}
+
+func TestRootedTreeRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(39))
+	for trial := 0; trial < 20; trial++ {
+		V := 1 + r.Intn(200)
+		parent := make([]int, V)
+		G := NewGraph(V)
+		// random tree over a random labeling, rooted at label[0]
+		label := r.Perm(V)
+		parent[label[0]] = -1
+		for i := 1; i < V; i++ {
+			parent[label[i]] = label[r.Intn(i)]
+			G.AddEdge(label[i], parent[label[i]])
+		}
+		tree := NewRootedTree(G, label[0])
+
+		ancestors := func(v int) (path []int) {
+			for ; v >= 0; v = parent[v] {
+				path = append(path, v)
+			}
+			return path
+		}
+		size := make([]int, V)
+		for v := 0; v < V; v++ {
+			for _, u := range ancestors(v) {
+				size[u]++
+			}
+		}
+		for v := 0; v < V; v++ {
+			assert.Equal(parent[v], tree.Parent(v))
+			assert.Equal(len(ancestors(v))-1, tree.Depth(v))
+			assert.Equal(size[v], tree.SubtreeSize(v))
+			for k, u := range ancestors(v) {
+				assert.Equal(u, tree.Ancestor(v, k))
+			}
+		}
+		for i := 0; i < 200; i++ {
+			v, w := r.Intn(V), r.Intn(V)
+			onPath := make(map[int]bool)
+			for _, u := range ancestors(v) {
+				onPath[u] = true
+			}
+			lca := -1
+			for _, u := range ancestors(w) {
+				if onPath[u] {
+					lca = u
+					break
+				}
+			}
+			assert.Equal(lca, tree.LCA(v, w))
+			assert.Equal(tree.Depth(v)+tree.Depth(w)-2*tree.Depth(lca), tree.Distance(v, w))
+			path := tree.Path(v, w)
+			assert.Len(path, tree.Distance(v, w)+1)
+			assert.Equal(v, path[0])
+			assert.Equal(w, path[len(path)-1])
+			for j := 1; j < len(path); j++ {
+				assert.True(parent[path[j]] == path[j-1] || parent[path[j-1]] == path[j])
+			}
+			assert.Equal(onPath[w], tree.IsAncestor(w, v))
+		}
+	}
+}
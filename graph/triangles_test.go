@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriangles(t *testing.T) {
+	assert := assert.New(t)
+
+	// the 4-clique 0-1-2-3, the pendant edge 3-4, a self-loop on 4, a parallel edge 0-1
+	// and the isolated vertex 5
+	g := NewGraph(6)
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 4}, {0, 1}} {
+		g.AddEdge(e[0], e[1])
+	}
+	tr := NewTriangles(g, 1)
+	assert.Equal(4, tr.Count())
+	assert.Equal([]int{3, 3, 3, 3, 0, 0}, []int{tr.Triangles(0), tr.Triangles(1), tr.Triangles(2),
+		tr.Triangles(3), tr.Triangles(4), tr.Triangles(5)})
+	assert.Equal(1.0, tr.Clustering(0))
+	assert.InEpsilon(0.5, tr.Clustering(3), 1e-12)
+	assert.Equal(0.0, tr.Clustering(4))
+	assert.InEpsilon(3.5/6, tr.AverageClustering(), 1e-12)
+	// 3 paths of length two centered at each of 0, 1 and 2, and 6 centered at 3
+	assert.InEpsilon(12.0/15, tr.Transitivity(), 1e-12)
+	assert.Panics(func() { tr.Triangles(6) })
+
+	empty := NewTriangles(NewGraph(0), 4)
+	assert.Equal(0, empty.Count())
+	assert.Equal(0.0, empty.AverageClustering())
+	assert.Equal(0.0, empty.Transitivity())
+}
+
+func TestTrianglesRandom(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(5))
+	V := 40
+	g := NewGraph(V)
+	adj := make([][]bool, V)
+	for v := range adj {
+		adj[v] = make([]bool, V)
+	}
+	for v := 0; v < V; v++ {
+		for w := v + 1; w < V; w++ {
+			if r.Float64() < 0.3 {
+				g.AddEdge(v, w)
+				adj[v][w], adj[w][v] = true, true
+			}
+		}
+	}
+
+	expected := make([]int, V)
+	total := 0
+	for a := 0; a < V; a++ {
+		for b := a + 1; b < V; b++ {
+			for c := b + 1; c < V; c++ {
+				if adj[a][b] && adj[b][c] && adj[a][c] {
+					expected[a]++
+					expected[b]++
+					expected[c]++
+					total++
+				}
+			}
+		}
+	}
+
+	sequential := NewTriangles(g, 1)
+	parallel := NewTriangles(g, 4)
+	assert.Equal(total, sequential.Count())
+	assert.Equal(total, parallel.Count())
+	for v := 0; v < V; v++ {
+		assert.Equal(expected[v], sequential.Triangles(v))
+		assert.Equal(expected[v], parallel.Triangles(v))
+	}
+	assert.Equal(sequential.Transitivity(), parallel.Transitivity())
+}
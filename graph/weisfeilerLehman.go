@@ -0,0 +1,18 @@
+package graph
+
+import "github.com/handane123/algorithms/internal/wl"
+
+// WeisfeilerLehmanHash returns a hash of the graph G that is the same for isomorphic graphs, which makes it
+// a fast filter before an exact test with NewIsomorphism: graphs with different hashes are not isomorphic,
+// though some nonisomorphic graphs share a hash. Every vertex starts with the given label (the empty label if
+// label is nil); in each of the given number of iterations, it is relabeled with a digest of its label and the
+// sorted labels of its neighbors, counted with multiplicity. The result is a digest of the sorted labels of all
+// iterations. Pass a label built from vertex names to hash labeled graphs, matching LabelsByName.
+// This implementation takes O(k (E + V) log V) time, where k is the number of iterations.
+func WeisfeilerLehmanHash(G *Graph, iterations int, label func(v int) string) string {
+	adj := make([][]int, G.V())
+	for v := range adj {
+		adj[v] = G.Adj(v)
+	}
+	return wl.Hash(G.V(), [][][]int{adj}, iterations, label)
+}
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeisfeilerLehmanHash(t *testing.T) {
+	assert := assert.New(t)
+
+	path := NewGraph(4)
+	star := NewGraph(4)
+	for i := 1; i < 4; i++ {
+		path.AddEdge(i-1, i)
+		star.AddEdge(0, i)
+	}
+	assert.NotEqual(WeisfeilerLehmanHash(path, 1, nil), WeisfeilerLehmanHash(star, 1, nil))
+	// without refinement only the number of vertices and the labels count
+	assert.Equal(WeisfeilerLehmanHash(path, 0, nil), WeisfeilerLehmanHash(star, 0, nil))
+	labels := func(v int) string { return []string{"a", "b", "b", "a"}[v] }
+	assert.NotEqual(WeisfeilerLehmanHash(path, 0, labels), WeisfeilerLehmanHash(star, 0, nil))
+
+	// regular graphs of the same degree and size cannot be told apart
+	cycle := NewGraph(6)
+	triangles := NewGraph(6)
+	for i := 0; i < 6; i++ {
+		cycle.AddEdge(i, (i+1)%6)
+		triangles.AddEdge(i, i/3*3+(i+1)%3)
+	}
+	assert.Equal(WeisfeilerLehmanHash(cycle, 3, nil), WeisfeilerLehmanHash(triangles, 3, nil))
+
+	assert.Equal(64, len(WeisfeilerLehmanHash(NewGraph(0), 2, nil)))
+	assert.Panics(func() { WeisfeilerLehmanHash(path, -1, nil) })
+}
@@ -0,0 +1,152 @@
+// Package dot reads the subset of the Graphviz DOT language written by the graph and digraph packages.
+package dot
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Edge is an edge statement read from the DOT language, with the value of its label attribute.
+type Edge struct {
+	V, W  int
+	Label string
+}
+
+// Read reads a graph, or a digraph if directed is true, written in the subset of the DOT language written by the
+// WriteDOT methods of the graph and digraph packages: statements, one per line, declaring a vertex or an edge with
+// optional attributes. It returns the number of vertices, one more than the largest vertex named, and the edges.
+// Every vertex below the largest one must be named, in a vertex or an edge statement, so the number of vertices
+// is bounded by the size of the input rather than by the largest index in it.
+func Read(r io.Reader, directed bool) (V int, edges []Edge, err error) {
+	header, op := "graph", "--"
+	if directed {
+		header, op = "digraph", "->"
+	}
+	scanner := bufio.NewScanner(r)
+	line, state := 0, 0        // 0 before the header, 1 in the body, 2 after it
+	seen := make(map[int]bool) // vertices named so far
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" || strings.HasPrefix(s, "//") {
+			continue
+		}
+		switch state {
+		case 0:
+			fields := strings.Fields(s)
+			if len(fields) < 2 || len(fields) > 3 || fields[0] != header || fields[len(fields)-1] != "{" {
+				return 0, nil, errors.Errorf("line %d: expected %s {", line, header)
+			}
+			state = 1
+		case 1:
+			if s == "}" {
+				state = 2
+				continue
+			}
+			e, isEdge, err := parseStatement(s, op)
+			if err != nil {
+				return 0, nil, errors.Wrapf(err, "line %d", line)
+			}
+			seen[e.V] = true
+			if e.V >= V {
+				V = e.V + 1
+			}
+			if isEdge {
+				seen[e.W] = true
+				if e.W >= V {
+					V = e.W + 1
+				}
+				edges = append(edges, e)
+			}
+		default:
+			return 0, nil, errors.Errorf("line %d: unexpected statement after }", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, err
+	}
+	if state != 2 {
+		return 0, nil, errors.New("unexpected end of input")
+	}
+	if len(seen) < V {
+		// some vertex among the first len(seen) + 1 is missing
+		v := 0
+		for seen[v] {
+			v++
+		}
+		return 0, nil, errors.Errorf("vertex %d is missing, but vertex %d is not", v, V-1)
+	}
+	return V, edges, nil
+}
+
+// parses a vertex statement v [attributes]; or an edge statement v op w [attributes];
+func parseStatement(s, op string) (e Edge, isEdge bool, err error) {
+	s = strings.TrimSuffix(s, ";")
+	var attributes string
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		if !strings.HasSuffix(s, "]") {
+			return e, false, errors.New("unterminated attribute list")
+		}
+		s, attributes = s[:i], s[i+1:len(s)-1]
+	}
+	ends := strings.Split(s, op)
+	if len(ends) > 2 {
+		return e, false, errors.New("expected one edge per statement")
+	}
+	isEdge = len(ends) == 2
+	if e.V, err = parseVertex(ends[0]); err != nil {
+		return e, false, err
+	}
+	if isEdge {
+		if e.W, err = parseVertex(ends[1]); err != nil {
+			return e, false, err
+		}
+	}
+	for attributes = strings.TrimSpace(attributes); attributes != ""; {
+		var key, value string
+		i := strings.IndexByte(attributes, '=')
+		if i < 0 {
+			return e, false, errors.Errorf("expected key=value in %q", attributes)
+		}
+		key, attributes = strings.TrimSpace(attributes[:i]), strings.TrimSpace(attributes[i+1:])
+		if strings.HasPrefix(attributes, "\"") {
+			end := 1
+			for end < len(attributes) && attributes[end] != '"' {
+				if attributes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(attributes) {
+				return e, false, errors.Errorf("unterminated value of %s", key)
+			}
+			if value, err = strconv.Unquote(attributes[:end+1]); err != nil {
+				return e, false, errors.Wrapf(err, "value of %s", key)
+			}
+			attributes = attributes[end+1:]
+		} else {
+			i := strings.IndexAny(attributes, ", ")
+			if i < 0 {
+				i = len(attributes)
+			}
+			value, attributes = attributes[:i], attributes[i:]
+		}
+		if key == "label" {
+			e.Label = value
+		}
+		attributes = strings.TrimLeft(attributes, ", ")
+	}
+	return e, isEdge, nil
+}
+
+func parseVertex(s string) (int, error) {
+	v, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || v < 0 {
+		return 0, errors.Errorf("vertex %q is not a non-negative integer", strings.TrimSpace(s))
+	}
+	return v, nil
+}
@@ -0,0 +1,37 @@
+package dot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	assert := assert.New(t)
+	V, edges, err := Read(strings.NewReader("digraph {\n  3;\n  4;\n  0 -> 1 [label=\"0.5\", color=red];\n  2 -> 2;\n}\n"), true)
+	assert.Nil(err)
+	assert.Equal(5, V)
+	assert.Equal([]Edge{{V: 0, W: 1, Label: "0.5"}, {V: 2, W: 2}}, edges)
+
+	V, edges, err = Read(strings.NewReader("graph {\n  // a comment\n  0;\n  2;\n  1 -- 3;\n}\n"), false)
+	assert.Nil(err)
+	assert.Equal(4, V)
+	assert.Equal([]Edge{{V: 1, W: 3}}, edges)
+
+	// every vertex is named, so a large index does not allocate its vertices
+	_, _, err = Read(strings.NewReader("graph {\n\t3000000000;\n}\n"), false)
+	assert.EqualError(err, "vertex 0 is missing, but vertex 3000000000 is not")
+	_, _, err = Read(strings.NewReader("graph {\n  0;\n  1 -- 3;\n}\n"), false)
+	assert.EqualError(err, "vertex 2 is missing, but vertex 3 is not")
+
+	// the header and edge operator must match the direction
+	_, _, err = Read(strings.NewReader("graph {\n}\n"), true)
+	assert.Error(err)
+	_, _, err = Read(strings.NewReader("digraph {\n  0 -- 1;\n}\n"), true)
+	assert.Error(err)
+	_, _, err = Read(strings.NewReader("graph {\n  0 -- 1;\n"), false)
+	assert.EqualError(err, "unexpected end of input")
+	_, _, err = Read(strings.NewReader("graph {\n  0 -- 1 [label=\"x];\n}\n"), false)
+	assert.Error(err)
+}
@@ -0,0 +1,120 @@
+package graphgen
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Lattice holds the edges of a geometric graph, each edge once, and the coordinates of its vertices.
+type Lattice struct {
+	Edges  [][2]int
+	Coords [][]float64
+	Sizes  []int // sides of a torus, to measure distances around it; nil for other graphs
+}
+
+// NewLattice returns the grid with the given number of vertices along each dimension, wrapped around into a torus
+// if wrap is true. Vertex v has coordinates given by the digits of v in the mixed radix of the sizes, the first fastest.
+func NewLattice(sizes []int, wrap bool) (*Lattice, error) {
+	V := 1
+	for _, n := range sizes {
+		if wrap && n < 3 {
+			return nil, errors.New("torus sides must be at least 3")
+		}
+		if n < 1 {
+			return nil, errors.New("grid sides must be positive")
+		}
+		V *= n
+	}
+	l := &Lattice{Coords: make([][]float64, V)}
+	if wrap {
+		l.Sizes = append([]int(nil), sizes...)
+	}
+	for v := 0; v < V; v++ {
+		l.Coords[v] = make([]float64, len(sizes))
+		stride := 1
+		for i, n := range sizes {
+			digit := v / stride % n
+			l.Coords[v][i] = float64(digit)
+			if digit+1 < n {
+				l.Edges = append(l.Edges, [2]int{v, v + stride})
+			} else if wrap {
+				l.Edges = append(l.Edges, [2]int{v, v - digit*stride})
+			}
+			stride *= n
+		}
+	}
+	return l, nil
+}
+
+// NewGeometricLattice returns V random points of the unit cube of the given dimension drawn from random,
+// joined when at most radius apart.
+func NewGeometricLattice(random *rand.Rand, V, dimension int, radius float64) (*Lattice, error) {
+	if V < 0 {
+		return nil, errors.New("number of vertices must be non negative")
+	}
+	if dimension < 1 {
+		return nil, errors.New("dimension must be positive")
+	}
+	if radius < 0 {
+		return nil, errors.New("radius must be non negative")
+	}
+	l := &Lattice{Coords: make([][]float64, V)}
+	for v := range l.Coords {
+		l.Coords[v] = make([]float64, dimension)
+		for i := range l.Coords[v] {
+			l.Coords[v][i] = random.Float64()
+		}
+	}
+	// sweep the points in order of their first coordinate
+	order := make([]int, V)
+	for v := range order {
+		order[v] = v
+	}
+	sort.Slice(order, func(i, j int) bool { return l.Coords[order[i]][0] < l.Coords[order[j]][0] })
+	for i, v := range order {
+		for _, w := range order[i+1:] {
+			if l.Coords[w][0]-l.Coords[v][0] > radius {
+				break
+			}
+			if l.Length(v, w) <= radius {
+				if v < w {
+					l.Edges = append(l.Edges, [2]int{v, w})
+				} else {
+					l.Edges = append(l.Edges, [2]int{w, v})
+				}
+			}
+		}
+	}
+	sort.Slice(l.Edges, func(i, j int) bool {
+		return l.Edges[i][0] < l.Edges[j][0] || l.Edges[i][0] == l.Edges[j][0] && l.Edges[i][1] < l.Edges[j][1]
+	})
+	return l, nil
+}
+
+// Length returns the Euclidean distance between the coordinates of v and w, around the torus for a torus.
+func (l *Lattice) Length(v, w int) float64 {
+	sum := 0.0
+	for i := range l.Coords[v] {
+		d := math.Abs(l.Coords[v][i] - l.Coords[w][i])
+		if l.Sizes != nil {
+			d = math.Min(d, float64(l.Sizes[i])-d)
+		}
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// NewHypercube returns the hypercube of the given dimension, the grid with 2 vertices along each dimension.
+func NewHypercube(dimension int) (*Lattice, error) {
+	if dimension < 0 || dimension > 30 {
+		return nil, errors.New("dimension must be between 0 and 30")
+	}
+	sizes := make([]int, dimension)
+	for i := range sizes {
+		sizes[i] = 2
+	}
+	return NewLattice(sizes, false)
+}
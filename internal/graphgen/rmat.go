@@ -0,0 +1,137 @@
+// Package graphgen holds the parts of the random graph generators shared by the graph and digraph packages.
+package graphgen
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+// ValidateRMAT returns an error unless scale is between 0 and 30 and the quadrant probabilities a, b, c
+// and 1-a-b-c of the R-MAT model are non negative.
+func ValidateRMAT(scale int, a, b, c float64) error {
+	if scale < 0 || scale > 30 {
+		return errors.New("scale must be between 0 and 30")
+	}
+	if a < 0 || b < 0 || c < 0 || rmatD(a, b, c) < 0 {
+		return errors.New("probabilities must be non negative and sum to at most 1")
+	}
+	return nil
+}
+
+// RMATEdges returns the number of distinct edges other than self-loops that the R-MAT model with the given
+// quadrant probabilities can place on 2^scale vertices, counting v->w and w->v as one edge unless directed.
+// An entry of the adjacency matrix can be chosen if and only if every quadrant containing it has a positive
+// probability, so the entries that can be chosen are the scale-th power of the quadrants that can.
+func RMATEdges(scale int, a, b, c float64, directed bool) int {
+	d := rmatD(a, b, c)
+	quadrants, diagonal, symmetric := 0, 0, 0
+	for _, p := range []float64{a, b, c, d} {
+		if p > 0 {
+			quadrants++
+		}
+	}
+	for _, p := range []float64{a, d} {
+		if p > 0 {
+			diagonal++
+		}
+	}
+	symmetric = diagonal
+	if b > 0 && c > 0 {
+		symmetric += 2
+	}
+	// ordered entries off the diagonal
+	entries := power(quadrants, scale) - power(diagonal, scale)
+	if directed {
+		return entries
+	}
+	// entries whose transpose can be chosen too are one edge with it
+	both := power(symmetric, scale) - power(diagonal, scale)
+	return entries - both/2
+}
+
+// returns the probability 1-a-b-c of the fourth quadrant, 0 if it is only rounding residue, so that
+// probabilities meant to sum to 1 leave the quadrant unreachable
+func rmatD(a, b, c float64) float64 {
+	d := 1 - a - b - c
+	if math.Abs(d) < 1e-12 {
+		return 0
+	}
+	return d
+}
+
+// returns n^k
+func power(n, k int) int {
+	p := 1
+	for i := 0; i < k; i++ {
+		p *= n
+	}
+	return p
+}
+
+// RMATEdge returns the row and column of a random entry of a 2^scale by 2^scale matrix chosen by the R-MAT model.
+// Only quadrants with a positive probability are chosen, even when rounding leaves a residue past the last one.
+func RMATEdge(random *rand.Rand, scale int, a, b, c float64) (v, w int) {
+	probabilities := [4]float64{a, b, c, rmatD(a, b, c)}
+	last := 0 // last quadrant with a positive probability
+	for q, p := range probabilities {
+		if p > 0 {
+			last = q
+		}
+	}
+	for bit := 1 << scale >> 1; bit > 0; bit >>= 1 {
+		r := random.Float64()
+		// quadrant q is in row half q/2 and column half q%2
+		quadrant, sum := last, 0.0
+		for q, p := range probabilities[:last] {
+			sum += p
+			if p > 0 && r < sum {
+				quadrant = q
+				break
+			}
+		}
+		if quadrant >= 2 {
+			v |= bit
+		}
+		if quadrant%2 == 1 {
+			w |= bit
+		}
+	}
+	return v, w
+}
+
+// ValidateInitiator returns an error unless the initiator is a nonempty square matrix of probabilities
+// and k is non negative.
+func ValidateInitiator(initiator [][]float64, k int) error {
+	if k < 0 {
+		return errors.New("power must be non negative")
+	}
+	if len(initiator) == 0 {
+		return errors.New("initiator must not be empty")
+	}
+	for _, row := range initiator {
+		if len(row) != len(initiator) {
+			return errors.New("initiator must be square")
+		}
+		for _, p := range row {
+			if p < 0.0 || p > 1.0 {
+				return errors.New("probability must be between 0 and 1")
+			}
+		}
+	}
+	return nil
+}
+
+// KroneckerProbability returns the probability of the edge from v to w in the k-th Kronecker power
+// of the initiator.
+func KroneckerProbability(initiator [][]float64, k, v, w int) float64 {
+	n := len(initiator)
+	p := 1.0
+	for i := 0; i < k; i++ {
+		p *= initiator[v%n][w%n]
+		v /= n
+		w /= n
+	}
+	return p
+}
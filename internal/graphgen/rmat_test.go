@@ -0,0 +1,43 @@
+package graphgen
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRMATEdges(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(8*7, RMATEdges(3, 0.25, 0.25, 0.25, true))
+	assert.Equal(8*7/2, RMATEdges(3, 0.25, 0.25, 0.25, false))
+	assert.Equal(7, RMATEdges(3, 0.5, 0.5, 0, true))
+	assert.Equal(7, RMATEdges(3, 0.5, 0.5, 0, false))
+	assert.Equal(0, RMATEdges(3, 1, 0, 0, false))
+	assert.Equal(8, RMATEdges(3, 0, 0.5, 0.5, true))
+	assert.Equal(4, RMATEdges(3, 0, 0.5, 0.5, false))
+	// probabilities that sum to 1 leave no reachable fourth quadrant, despite rounding
+	assert.Equal(3*3*3-1, RMATEdges(3, 0.6, 0.3, 0.1, true))
+	assert.Equal(3*3*3-1, RMATEdges(3, 0.7, 0.2, 0.1, true))
+	assert.Nil(ValidateRMAT(3, 0.6, 0.3, 0.1))
+	assert.Error(ValidateRMAT(3, 0.6, 0.3, 0.2))
+
+	// the count agrees with the entries drawn
+	random := rand.New(rand.NewSource(7))
+	for _, p := range [][3]float64{{0.5, 0.5, 0}, {0.6, 0, 0.2}, {0, 0.3, 0.3}, {0.2, 0.3, 0}, {0.6, 0.3, 0.1}} {
+		directed := make(map[[2]int]bool)
+		undirected := make(map[[2]int]bool)
+		for i := 0; i < 20000; i++ {
+			v, w := RMATEdge(random, 4, p[0], p[1], p[2])
+			if v != w {
+				directed[[2]int{v, w}] = true
+				if v > w {
+					v, w = w, v
+				}
+				undirected[[2]int{v, w}] = true
+			}
+		}
+		assert.Equal(len(directed), RMATEdges(4, p[0], p[1], p[2], true), p)
+		assert.Equal(len(undirected), RMATEdges(4, p[0], p[1], p[2], false), p)
+	}
+}
@@ -0,0 +1,224 @@
+// Package vf2 matches the vertices of a pattern graph or digraph to those of a target with the VF2 algorithm,
+// shared by the graph and digraph packages.
+package vf2
+
+import "sort"
+
+// Kind is the kind of matching to find.
+type Kind int
+
+const (
+	// Isomorphism is a bijection that preserves the edges and their multiplicities.
+	Isomorphism Kind = iota
+	// InducedSubgraph maps the pattern one-to-one onto the subgraph of the target induced by the image.
+	InducedSubgraph
+	// Subgraph maps the pattern one-to-one onto some vertices of the target, keeping every edge of the pattern.
+	Subgraph
+)
+
+// Graph is one side of a matching, given by its adjacency.
+type Graph struct {
+	Neighbors [][]int       // Neighbors[v] = distinct vertices joined to v by an edge in either direction, ascending
+	Count     []map[int]int // Count[v][w] = number of edges v->w, a self-loop of an undirected graph counted twice
+	Outdegree []int         // outdegree of every vertex of a digraph; nil for an undirected graph
+	Indegree  []int         // indegree of every vertex of a digraph; nil for an undirected graph
+}
+
+// Match returns the target vertex matched to every pattern vertex by a matching of the given kind that matches
+// only compatible vertices, or nil if there is none; compatible may be nil to allow every pair.
+// It visits the pattern in breadth-first order from vertices of high degree, tries only the target neighbors
+// of an already matched neighbor, and prunes pairs whose degrees or numbers of matched, frontier and unexplored
+// neighbors cannot be reconciled.
+func Match(pattern, target *Graph, kind Kind, compatible func(v, w int) bool) []int {
+	s := &state{kind: kind, compatible: compatible, pattern: pattern, target: target}
+	P, T := len(pattern.Neighbors), len(target.Neighbors)
+	s.coreP, s.frontierP = make([]int, P), make([]int, P)
+	s.coreT, s.frontierT = make([]int, T), make([]int, T)
+	for v := range s.coreP {
+		s.coreP[v] = -1
+	}
+	for v := range s.coreT {
+		s.coreT[v] = -1
+		s.verticesT = append(s.verticesT, v)
+	}
+	s.order, s.parent = matchingOrder(pattern.Neighbors)
+	if !s.match(0) {
+		return nil
+	}
+	return s.coreP
+}
+
+// returns the vertices in breadth-first order, starting each component from a vertex of largest degree,
+// and the position in the order of the neighbor through which each vertex was reached, -1 for the roots
+func matchingOrder(neighbors [][]int) (order, parent []int) {
+	V := len(neighbors)
+	byDegree := make([]int, V)
+	for v := range byDegree {
+		byDegree[v] = v
+	}
+	sort.SliceStable(byDegree, func(i, j int) bool { return len(neighbors[byDegree[i]]) > len(neighbors[byDegree[j]]) })
+	position := make([]int, V)
+	for v := range position {
+		position[v] = -1
+	}
+	for _, root := range byDegree {
+		if position[root] >= 0 {
+			continue
+		}
+		position[root] = len(order)
+		order = append(order, root)
+		parent = append(parent, -1)
+		for head := position[root]; head < len(order); head++ {
+			for _, w := range neighbors[order[head]] {
+				if position[w] < 0 {
+					position[w] = len(order)
+					order = append(order, w)
+					parent = append(parent, head)
+				}
+			}
+		}
+	}
+	return order, parent
+}
+
+// state holds the state of the VF2 search. The frontier of a side is the set of unmatched vertices adjacent to
+// a matched vertex; frontier[v] is the depth at which v joined the matching or the frontier, 0 if it has not.
+type state struct {
+	kind                 Kind
+	compatible           func(v, w int) bool
+	pattern, target      *Graph
+	verticesT            []int // all target vertices
+	coreP, coreT         []int // coreP[v] = target vertex matched to v, -1 if none; coreT the inverse
+	frontierP, frontierT []int
+	order                []int // pattern vertices in matching order
+	parent               []int // parent[i] = position of an earlier neighbor of order[i], -1 if none
+}
+
+// extends the matching of the first depth vertices of the order; returns true if it was completed
+func (s *state) match(depth int) bool {
+	if depth == len(s.order) {
+		return true
+	}
+	u := s.order[depth]
+	// a vertex reached through a matched neighbor must be matched to a neighbor of its image
+	candidates := s.verticesT
+	if p := s.parent[depth]; p >= 0 {
+		candidates = s.target.Neighbors[s.coreP[s.order[p]]]
+	}
+	for _, x := range candidates {
+		if s.coreT[x] >= 0 || !s.feasible(u, x) {
+			continue
+		}
+		s.add(depth+1, u, x)
+		if s.match(depth + 1) {
+			return true
+		}
+		s.remove(depth+1, u, x)
+	}
+	return false
+}
+
+// can the pattern vertex u be matched to the target vertex x?
+func (s *state) feasible(u, x int) bool {
+	if s.compatible != nil && !s.compatible(u, x) {
+		return false
+	}
+	if s.pattern.Outdegree != nil &&
+		(!s.fitsDegree(s.pattern.Outdegree[u], s.target.Outdegree[x]) ||
+			!s.fitsDegree(s.pattern.Indegree[u], s.target.Indegree[x])) {
+		return false
+	}
+	if !s.fits(s.pattern.Count[u][u], s.target.Count[x][x]) {
+		return false
+	}
+	matchedP, frontierP, restP := 0, 0, 0
+	for _, v := range s.pattern.Neighbors[u] {
+		switch {
+		case s.coreP[v] >= 0:
+			matchedP++
+			y := s.coreP[v]
+			if !s.fits(s.pattern.Count[u][v], s.target.Count[x][y]) || !s.fits(s.pattern.Count[v][u], s.target.Count[y][x]) {
+				return false
+			}
+		case s.frontierP[v] > 0:
+			frontierP++
+		default:
+			restP++
+		}
+	}
+	matchedT, frontierT, restT := 0, 0, 0
+	for _, y := range s.target.Neighbors[x] {
+		switch {
+		case s.coreT[y] >= 0:
+			matchedT++
+		case s.frontierT[y] > 0:
+			frontierT++
+		default:
+			restT++
+		}
+	}
+	// every matched neighbor of u corresponds to a matched neighbor of x, so equal counts rule out extra edges
+	if s.kind == Isomorphism {
+		return matchedP == matchedT && frontierP == frontierT && restP == restT
+	}
+	if s.kind == InducedSubgraph && matchedP != matchedT {
+		return false
+	}
+	return frontierP <= frontierT && frontierP+restP <= frontierT+restT
+}
+
+// can p edges of the pattern be matched to t edges of the target?
+func (s *state) fits(p, t int) bool {
+	if s.kind == Subgraph {
+		return p <= t
+	}
+	return p == t
+}
+
+// can a pattern vertex of degree p be matched to a target vertex of degree t?
+func (s *state) fitsDegree(p, t int) bool {
+	if s.kind == Isomorphism {
+		return p == t
+	}
+	return p <= t
+}
+
+func (s *state) add(depth, u, x int) {
+	s.coreP[u], s.coreT[x] = x, u
+	if s.frontierP[u] == 0 {
+		s.frontierP[u] = depth
+	}
+	for _, v := range s.pattern.Neighbors[u] {
+		if s.frontierP[v] == 0 {
+			s.frontierP[v] = depth
+		}
+	}
+	if s.frontierT[x] == 0 {
+		s.frontierT[x] = depth
+	}
+	for _, y := range s.target.Neighbors[x] {
+		if s.frontierT[y] == 0 {
+			s.frontierT[y] = depth
+		}
+	}
+}
+
+func (s *state) remove(depth, u, x int) {
+	s.coreP[u], s.coreT[x] = -1, -1
+	if s.frontierP[u] == depth {
+		s.frontierP[u] = 0
+	}
+	for _, v := range s.pattern.Neighbors[u] {
+		if s.frontierP[v] == depth {
+			s.frontierP[v] = 0
+		}
+	}
+	if s.frontierT[x] == depth {
+		s.frontierT[x] = 0
+	}
+	for _, y := range s.target.Neighbors[x] {
+		if s.frontierT[y] == depth {
+			s.frontierT[y] = 0
+		}
+	}
+}
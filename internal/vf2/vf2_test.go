@@ -0,0 +1,45 @@
+package vf2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the adjacency of the undirected graph with the given edges
+func undirected(V int, edges [][2]int) *Graph {
+	g := &Graph{Neighbors: make([][]int, V), Count: make([]map[int]int, V)}
+	for v := range g.Count {
+		g.Count[v] = make(map[int]int)
+	}
+	for _, e := range edges {
+		v, w := e[0], e[1]
+		if g.Count[v][w] == 0 && v != w {
+			g.Neighbors[v] = append(g.Neighbors[v], w)
+			g.Neighbors[w] = append(g.Neighbors[w], v)
+		}
+		g.Count[v][w]++
+		g.Count[w][v]++
+	}
+	return g
+}
+
+func TestMatch(t *testing.T) {
+	assert := assert.New(t)
+	path := undirected(3, [][2]int{{0, 1}, {1, 2}})
+	triangle := undirected(3, [][2]int{{0, 1}, {1, 2}, {2, 0}})
+	bent := undirected(3, [][2]int{{1, 0}, {0, 2}})
+
+	assert.Equal([]int{1, 0, 2}, Match(path, bent, Isomorphism, nil))
+	assert.Nil(Match(path, triangle, Isomorphism, nil))
+	assert.NotNil(Match(path, triangle, Subgraph, nil))
+	assert.Nil(Match(path, triangle, InducedSubgraph, nil))
+	// the middle of the path can only go to the middle
+	assert.Nil(Match(path, bent, Isomorphism, func(v, w int) bool { return v != 1 || w != 0 }))
+
+	// directed, an edge cannot be matched to its reverse
+	forward := &Graph{Neighbors: [][]int{{1}, {0}}, Count: []map[int]int{{1: 1}, {}},
+		Outdegree: []int{1, 0}, Indegree: []int{0, 1}}
+	assert.Equal([]int{0, 1}, Match(forward, forward, Isomorphism, nil))
+	assert.Nil(Match(forward, forward, Isomorphism, func(v, w int) bool { return v != w }))
+}
@@ -0,0 +1,70 @@
+// Package wl computes Weisfeiler-Lehman hashes of graphs and digraphs given by their adjacency lists,
+// shared by the graph and digraph packages.
+package wl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Hash returns the Weisfeiler-Lehman hash of the graph on V vertices whose neighbors of vertex v, in each of
+// the given kinds (the neighbors of an undirected graph, or the out-neighbors and in-neighbors of a digraph),
+// are adjacencies[k][v]. Every vertex starts with the given label (the empty label if label is nil); in each
+// of the given number of iterations, it is relabeled with a digest of its label and, for every kind, the sorted
+// labels of its neighbors, counted with multiplicity. The result is a digest of the sorted labels of all
+// iterations. It takes O(k (E + V) log V) time, where k is the number of iterations.
+func Hash(V int, adjacencies [][][]int, iterations int, label func(v int) string) string {
+	if iterations < 0 {
+		panic("number of iterations must be non negative")
+	}
+	labels := make([]string, V)
+	for v := 0; v < V; v++ {
+		if label != nil {
+			labels[v] = digest(label(v))
+		} else {
+			labels[v] = digest("")
+		}
+	}
+
+	var summary strings.Builder
+	histogram := func() {
+		sorted := append([]string(nil), labels...)
+		sort.Strings(sorted)
+		summary.WriteString(strconv.Itoa(len(sorted)))
+		for _, l := range sorted {
+			summary.WriteString(l)
+		}
+	}
+	neighborLabels := func(vertices []int) string {
+		names := make([]string, len(vertices))
+		for j, w := range vertices {
+			names[j] = labels[w]
+		}
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	}
+	histogram()
+	next := make([]string, V)
+	for i := 0; i < iterations; i++ {
+		for v := 0; v < V; v++ {
+			var s strings.Builder
+			s.WriteString(labels[v])
+			for _, adj := range adjacencies {
+				s.WriteString("(" + neighborLabels(adj[v]) + ")")
+			}
+			next[v] = digest(s.String())
+		}
+		labels, next = next, labels
+		histogram()
+	}
+	return digest(summary.String())
+}
+
+// returns the hexadecimal SHA-256 digest of s
+func digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
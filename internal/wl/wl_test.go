@@ -0,0 +1,24 @@
+package wl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	assert := assert.New(t)
+	path := [][]int{{1}, {0, 2}, {1}}
+	relabeled := [][]int{{2}, {2}, {0, 1}}
+	centered := [][]int{{1, 2}, {0}, {0}}
+	triangle := [][]int{{1, 2}, {0, 2}, {0, 1}}
+
+	assert.Equal(Hash(3, [][][]int{path}, 2, nil), Hash(3, [][][]int{relabeled}, 2, nil))
+	assert.Equal(Hash(3, [][][]int{path}, 2, nil), Hash(3, [][][]int{centered}, 2, nil))
+	assert.NotEqual(Hash(3, [][][]int{path}, 1, nil), Hash(3, [][][]int{triangle}, 1, nil))
+	// the iterations, labels and kinds of neighbors all count
+	assert.Equal(Hash(3, [][][]int{path}, 0, nil), Hash(3, [][][]int{triangle}, 0, nil))
+	assert.NotEqual(Hash(3, [][][]int{path}, 1, nil), Hash(3, [][][]int{path}, 1, func(v int) string { return "x" }))
+	assert.NotEqual(Hash(3, [][][]int{path}, 1, nil), Hash(3, [][][]int{path, path}, 1, nil))
+	assert.Panics(func() { Hash(3, [][][]int{path}, -1, nil) })
+}